@@ -0,0 +1,64 @@
+package core
+
+import "context"
+
+// CatchSchema succeeds with a fallback value whenever the inner schema fails.
+type CatchSchema[T any] struct {
+	Inner    Parser[T]
+	Fallback T
+}
+
+func NewCatchSchema[T any](inner Parser[T], fallback T) *CatchSchema[T] {
+	return &CatchSchema[T]{Inner: inner, Fallback: fallback}
+}
+
+func (s *CatchSchema[T]) Parse(value interface{}) *Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *CatchSchema[T]) ParseContext(ctx context.Context, value interface{}) *Result[T] {
+	return ParseContext[T](ctx, s, value)
+}
+
+func (s *CatchSchema[T]) ParseState(value interface{}, state *State) *Result[T] {
+	return s.catch(ParseState(s.Inner, value, state))
+}
+
+func (s *CatchSchema[T]) ParseTyped(value T) *Result[T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *CatchSchema[T]) ParseTypedState(value T, state *State) *Result[T] {
+	return s.catch(ParseTypedState(s.Inner, value, state))
+}
+
+func (s *CatchSchema[T]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *CatchSchema[T]) Describe() *Description {
+	return Describe(s.Inner)
+}
+
+func (s *CatchSchema[T]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *CatchSchema[T]) ParseAbsent(state *State) (*Result[interface{}], bool) {
+	if absentParser, isAbsentParser := s.Inner.(AbsentParser); isAbsentParser {
+		result, present := absentParser.ParseAbsent(state)
+		if result.Ok {
+			return result, present
+		}
+	}
+
+	return &Result[interface{}]{Ok: true, Value: s.Fallback}, true
+}
+
+func (s *CatchSchema[T]) catch(result *Result[T]) *Result[T] {
+	if result.Ok {
+		return result
+	}
+
+	return &Result[T]{Ok: true, Path: result.Path, Value: s.Fallback}
+}
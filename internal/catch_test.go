@@ -0,0 +1,40 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatchSchema(t *testing.T) {
+	schema := core.NewCatchSchema[int](primitives.NewNumberSchema[int]("Page").Positive(), 1)
+
+	result := schema.Parse(5)
+	assert.True(t, result.Ok)
+	assert.Equal(t, 5, result.Value)
+
+	result = schema.Parse("five")
+	assert.True(t, result.Ok)
+	assert.Equal(t, 1, result.Value)
+	assert.Empty(t, result.Issues)
+
+	result = schema.ParseTyped(-5)
+	assert.True(t, result.Ok)
+	assert.Equal(t, 1, result.Value)
+
+	absentResult, present := schema.ParseAbsent(nil)
+	assert.True(t, absentResult.Ok)
+	assert.True(t, present)
+	assert.Equal(t, 1, absentResult.Value)
+}
+
+func TestCatchSchema_AbsentInner(t *testing.T) {
+	inner := core.NewDefaultSchema[int](primitives.NewNumberSchema[int]("Page"), func() int { return 10 })
+	schema := core.NewCatchSchema[int](inner, 1)
+
+	absentResult, present := schema.ParseAbsent(nil)
+	assert.True(t, present)
+	assert.Equal(t, 10, absentResult.Value)
+}
@@ -1,6 +1,7 @@
 package coercion
 
 import (
+	"context"
 	"strings"
 
 	core "github.com/abyanmajid/v/internal"
@@ -18,6 +19,14 @@ func NewCoerceBooleanSchema(path string) *CoerceBooleanSchema {
 }
 
 func (c *CoerceBooleanSchema) Parse(value interface{}) *core.Result[bool] {
+	return c.ParseState(value, nil)
+}
+
+func (c *CoerceBooleanSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[bool] {
+	return core.ParseContext[bool](ctx, c, value)
+}
+
+func (c *CoerceBooleanSchema) ParseState(value interface{}, state *core.State) *core.Result[bool] {
 	var coercedValue bool
 	switch v := value.(type) {
 	case bool:
@@ -29,7 +38,7 @@ func (c *CoerceBooleanSchema) Parse(value interface{}) *core.Result[bool] {
 		} else if v == "false" {
 			coercedValue = false
 		} else {
-			return c.Inner.Schema.NewErrorResult("Must be a value that can be casted to a boolean")
+			return c.Inner.Schema.NewTypeErrorResult(value, "boolean", "Must be a value that can be casted to a boolean")
 		}
 	case int:
 		if v == 0 {
@@ -37,15 +46,83 @@ func (c *CoerceBooleanSchema) Parse(value interface{}) *core.Result[bool] {
 		} else if v == 1 {
 			coercedValue = true
 		} else {
-			return c.Inner.Schema.NewErrorResult("Must be a value that can be casted to a boolean")
+			return c.Inner.Schema.NewTypeErrorResult(value, "boolean", "Must be a value that can be casted to a boolean")
 		}
 	default:
-		return c.Inner.Schema.NewErrorResult("Must be a value that can be casted to a boolean")
+		return c.Inner.Schema.NewTypeErrorResult(value, "boolean", "Must be a value that can be casted to a boolean")
 	}
 
-	return c.ParseTyped(coercedValue)
+	return c.Inner.Schema.ParseGenericState(coercedValue, state)
 }
 
 func (c *CoerceBooleanSchema) ParseTyped(value bool) *core.Result[bool] {
 	return c.Inner.ParseTyped(value)
 }
+
+func (c *CoerceBooleanSchema) ParseTypedState(value bool, state *core.State) *core.Result[bool] {
+	return c.Inner.ParseTypedState(value, state)
+}
+
+func (c *CoerceBooleanSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return c.Parse(value).ToAny()
+}
+
+func (c *CoerceBooleanSchema) Describe() *core.Description {
+	return c.Inner.Describe()
+}
+
+func (c *CoerceBooleanSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return c.ParseState(value, state).ToAny()
+}
+
+func (c *CoerceBooleanSchema) Default(value bool) *core.DefaultSchema[bool] {
+	return core.NewDefaultSchema[bool](c, func() bool { return value })
+}
+
+func (c *CoerceBooleanSchema) DefaultFunc(defaultValue func() bool) *core.DefaultSchema[bool] {
+	return core.NewDefaultSchema[bool](c, defaultValue)
+}
+
+func (c *CoerceBooleanSchema) Catch(fallback bool) *core.CatchSchema[bool] {
+	return core.NewCatchSchema[bool](c, fallback)
+}
+
+func (c *CoerceBooleanSchema) Refine(check func(bool) bool, message string, opts ...core.RefineOptions) *CoerceBooleanSchema {
+	c.Inner.Schema.Refine(check, message, opts...)
+	return c
+}
+
+func (c *CoerceBooleanSchema) SuperRefine(refinement func(value bool, ctx *core.RefinementCtx)) *CoerceBooleanSchema {
+	c.Inner.Schema.SuperRefine(refinement)
+	return c
+}
+
+func (c *CoerceBooleanSchema) RefineContext(check func(ctx context.Context, value bool) error) *CoerceBooleanSchema {
+	c.Inner.Schema.RefineContext(check)
+	return c
+}
+
+func (c *CoerceBooleanSchema) Messages(messageFunc core.MessageFunc) *CoerceBooleanSchema {
+	c.Inner.Schema.MessageFunc = messageFunc
+	return c
+}
+
+func (c *CoerceBooleanSchema) FailFast() *CoerceBooleanSchema {
+	c.Inner.Schema.FailFast = true
+	return c
+}
+
+func (c *CoerceBooleanSchema) Description(text string) *CoerceBooleanSchema {
+	c.Inner.Schema.Doc = text
+	return c
+}
+
+func (c *CoerceBooleanSchema) Example(value bool) *CoerceBooleanSchema {
+	c.Inner.Schema.Examples = append(c.Inner.Schema.Examples, value)
+	return c
+}
+
+func (c *CoerceBooleanSchema) Then() *CoerceBooleanSchema {
+	c.Inner.Schema.Then()
+	return c
+}
@@ -1,6 +1,7 @@
 package coercion
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -19,6 +20,14 @@ func NewCoerceDateSchema(path string) *CoerceDateSchema {
 }
 
 func (c *CoerceDateSchema) Parse(value interface{}) *core.Result[time.Time] {
+	return c.ParseState(value, nil)
+}
+
+func (c *CoerceDateSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[time.Time] {
+	return core.ParseContext[time.Time](ctx, c, value)
+}
+
+func (c *CoerceDateSchema) ParseState(value interface{}, state *core.State) *core.Result[time.Time] {
 	var coercedValue time.Time
 	switch v := value.(type) {
 	case time.Time:
@@ -26,33 +35,109 @@ func (c *CoerceDateSchema) Parse(value interface{}) *core.Result[time.Time] {
 	case string:
 		parsedTime, err := time.Parse(time.RFC3339, v)
 		if err != nil {
-			return c.Inner.Schema.NewErrorResult(fmt.Sprintf("Must be a valid ISO 8601 date string, got: %v", v))
+			return c.Inner.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.InvalidDate,
+				Params:  core.Params{"format": "RFC 3339"},
+				Message: fmt.Sprintf("Must be a valid ISO 8601 date string, got: %v", v),
+			})
 		}
 		coercedValue = parsedTime
 	case int, int64, float64:
 		timestamp, ok := CoerceToInt64(v)
 		if !ok {
-			return c.Inner.Schema.NewErrorResult(fmt.Sprintf("Must be a valid Unix timestamp, got: %v", v))
+			return c.Inner.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.InvalidDate,
+				Params:  core.Params{"format": "Unix timestamp"},
+				Message: fmt.Sprintf("Must be a valid Unix timestamp, got: %v", v),
+			})
 		}
 		coercedValue = time.Unix(timestamp, 0)
 	default:
-		return c.Inner.Schema.NewErrorResult("Must be a value that can be casted to a date")
+		return c.Inner.Schema.NewTypeErrorResult(value, "date", "Must be a value that can be casted to a date")
 	}
 
-	return c.ParseTyped(coercedValue)
+	return c.Inner.Schema.ParseGenericState(coercedValue, state)
 }
 
 func (c *CoerceDateSchema) ParseTyped(value time.Time) *core.Result[time.Time] {
 	return c.Inner.ParseTyped(value)
 }
 
-func (c *CoerceDateSchema) Min(earliest time.Time) *CoerceDateSchema {
-	c.Inner.Min(earliest)
+func (c *CoerceDateSchema) ParseTypedState(value time.Time, state *core.State) *core.Result[time.Time] {
+	return c.Inner.ParseTypedState(value, state)
+}
+
+func (c *CoerceDateSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return c.Parse(value).ToAny()
+}
+
+func (c *CoerceDateSchema) Describe() *core.Description {
+	return c.Inner.Describe()
+}
+
+func (c *CoerceDateSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return c.ParseState(value, state).ToAny()
+}
+
+func (c *CoerceDateSchema) Default(value time.Time) *core.DefaultSchema[time.Time] {
+	return core.NewDefaultSchema[time.Time](c, func() time.Time { return value })
+}
+
+func (c *CoerceDateSchema) DefaultFunc(defaultValue func() time.Time) *core.DefaultSchema[time.Time] {
+	return core.NewDefaultSchema[time.Time](c, defaultValue)
+}
+
+func (c *CoerceDateSchema) Catch(fallback time.Time) *core.CatchSchema[time.Time] {
+	return core.NewCatchSchema[time.Time](c, fallback)
+}
+
+func (c *CoerceDateSchema) Refine(check func(time.Time) bool, message string, opts ...core.RefineOptions) *CoerceDateSchema {
+	c.Inner.Schema.Refine(check, message, opts...)
+	return c
+}
+
+func (c *CoerceDateSchema) SuperRefine(refinement func(value time.Time, ctx *core.RefinementCtx)) *CoerceDateSchema {
+	c.Inner.Schema.SuperRefine(refinement)
+	return c
+}
+
+func (c *CoerceDateSchema) RefineContext(check func(ctx context.Context, value time.Time) error) *CoerceDateSchema {
+	c.Inner.Schema.RefineContext(check)
+	return c
+}
+
+func (c *CoerceDateSchema) Messages(messageFunc core.MessageFunc) *CoerceDateSchema {
+	c.Inner.Schema.MessageFunc = messageFunc
+	return c
+}
+
+func (c *CoerceDateSchema) FailFast() *CoerceDateSchema {
+	c.Inner.Schema.FailFast = true
+	return c
+}
+
+func (c *CoerceDateSchema) Description(text string) *CoerceDateSchema {
+	c.Inner.Schema.Doc = text
+	return c
+}
+
+func (c *CoerceDateSchema) Example(value time.Time) *CoerceDateSchema {
+	c.Inner.Schema.Examples = append(c.Inner.Schema.Examples, value)
+	return c
+}
+
+func (c *CoerceDateSchema) Then() *CoerceDateSchema {
+	c.Inner.Schema.Then()
+	return c
+}
+
+func (c *CoerceDateSchema) Min(earliest time.Time, message ...string) *CoerceDateSchema {
+	c.Inner.Min(earliest, message...)
 	return c
 }
 
-func (c *CoerceDateSchema) Max(latest time.Time) *CoerceDateSchema {
-	c.Inner.Max(latest)
+func (c *CoerceDateSchema) Max(latest time.Time, message ...string) *CoerceDateSchema {
+	c.Inner.Max(latest, message...)
 	return c
 }
 
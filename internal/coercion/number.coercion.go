@@ -1,6 +1,7 @@
 package coercion
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -19,66 +20,152 @@ func NewCoerceNumberSchema[T primitives.Number](path string) *CoerceNumberSchema
 }
 
 func (c *CoerceNumberSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return c.ParseState(value, nil)
+}
+
+func (c *CoerceNumberSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, c, value)
+}
+
+func (c *CoerceNumberSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
 	coercedValue := fmt.Sprint(value)
 
 	parsedValue, err := strconv.ParseFloat(coercedValue, 64)
 	if err != nil {
-		return c.Inner.Schema.NewErrorResult("Must be a value that can be casted to a number")
+		return c.Inner.Schema.NewTypeErrorResult(value, "number", "Must be a value that can be casted to a number")
 	}
 
-	return c.ParseTyped(T(parsedValue))
+	return c.Inner.Schema.ParseGenericState(T(parsedValue), state)
 }
 
 func (c *CoerceNumberSchema[T]) ParseTyped(value T) *core.Result[T] {
 	return c.Inner.ParseTyped(value)
 }
 
-func (c *CoerceNumberSchema[T]) Gt(lowerBound T) *CoerceNumberSchema[T] {
-	c.Inner.Gt(lowerBound)
+func (c *CoerceNumberSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	return c.Inner.ParseTypedState(value, state)
+}
+
+func (c *CoerceNumberSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return c.Parse(value).ToAny()
+}
+
+func (c *CoerceNumberSchema[T]) Describe() *core.Description {
+	return c.Inner.Describe()
+}
+
+func (c *CoerceNumberSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return c.ParseState(value, state).ToAny()
+}
+
+func (c *CoerceNumberSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](c, func() T { return value })
+}
+
+func (c *CoerceNumberSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](c, defaultValue)
+}
+
+func (c *CoerceNumberSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](c, fallback)
+}
+
+func (c *CoerceNumberSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *CoerceNumberSchema[T] {
+	c.Inner.Schema.Refine(check, message, opts...)
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *CoerceNumberSchema[T] {
+	c.Inner.Schema.SuperRefine(refinement)
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *CoerceNumberSchema[T] {
+	c.Inner.Schema.RefineContext(check)
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) Messages(messageFunc core.MessageFunc) *CoerceNumberSchema[T] {
+	c.Inner.Schema.MessageFunc = messageFunc
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) FailFast() *CoerceNumberSchema[T] {
+	c.Inner.Schema.FailFast = true
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) Description(text string) *CoerceNumberSchema[T] {
+	c.Inner.Schema.Doc = text
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) Example(value T) *CoerceNumberSchema[T] {
+	c.Inner.Schema.Examples = append(c.Inner.Schema.Examples, value)
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) Then() *CoerceNumberSchema[T] {
+	c.Inner.Schema.Then()
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) Gt(lowerBound T, message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Gt(lowerBound, message...)
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) Gte(lowerBound T, message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Gte(lowerBound, message...)
+	return c
+}
+
+func (c *CoerceNumberSchema[T]) Lt(upperBound T, message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Lt(upperBound, message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) Gte(lowerBound T) *CoerceNumberSchema[T] {
-	c.Inner.Gte(lowerBound)
+func (c *CoerceNumberSchema[T]) Lte(upperBound T, message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Lte(upperBound, message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) Lt(upperBound T) *CoerceNumberSchema[T] {
-	c.Inner.Lt(upperBound)
+func (c *CoerceNumberSchema[T]) Positive(message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Positive(message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) Lte(upperBound T) *CoerceNumberSchema[T] {
-	c.Inner.Lte(upperBound)
+func (c *CoerceNumberSchema[T]) NonNegative(message ...string) *CoerceNumberSchema[T] {
+	c.Inner.NonNegative(message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) Positive() *CoerceNumberSchema[T] {
-	c.Inner.Positive()
+func (c *CoerceNumberSchema[T]) Negative(message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Negative(message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) NonNegative() *CoerceNumberSchema[T] {
-	c.Inner.NonNegative()
+func (c *CoerceNumberSchema[T]) NonPositive(message ...string) *CoerceNumberSchema[T] {
+	c.Inner.NonPositive(message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) Negative() *CoerceNumberSchema[T] {
-	c.Inner.Negative()
+func (c *CoerceNumberSchema[T]) MultipleOf(step T, message ...string) *CoerceNumberSchema[T] {
+	c.Inner.MultipleOf(step, message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) NonPositive() *CoerceNumberSchema[T] {
-	c.Inner.NonPositive()
+func (c *CoerceNumberSchema[T]) Finite(message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Finite(message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) MultipleOf(step T) *CoerceNumberSchema[T] {
-	c.Inner.MultipleOf(step)
+func (c *CoerceNumberSchema[T]) Exists(lookup core.Lookup[T], message ...string) *CoerceNumberSchema[T] {
+	c.Inner.Exists(lookup, message...)
 	return c
 }
 
-func (c *CoerceNumberSchema[T]) Finite() *CoerceNumberSchema[T] {
-	c.Inner.Finite()
+func (c *CoerceNumberSchema[T]) NotExists(lookup core.Lookup[T], message ...string) *CoerceNumberSchema[T] {
+	c.Inner.NotExists(lookup, message...)
 	return c
 }
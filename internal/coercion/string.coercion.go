@@ -1,6 +1,7 @@
 package coercion
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -19,100 +20,186 @@ func NewCoerceStringSchema(path string) *CoerceStringSchema {
 }
 
 func (c *CoerceStringSchema) Parse(value interface{}) *core.Result[string] {
+	return c.ParseState(value, nil)
+}
+
+func (c *CoerceStringSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[string] {
+	return core.ParseContext[string](ctx, c, value)
+}
+
+func (c *CoerceStringSchema) ParseState(value interface{}, state *core.State) *core.Result[string] {
 	coercedValue := fmt.Sprint(value)
-	return c.ParseTyped(coercedValue)
+	return c.Inner.Schema.ParseGenericState(coercedValue, state)
 }
 
 func (c *CoerceStringSchema) ParseTyped(value string) *core.Result[string] {
 	return c.Inner.ParseTyped(value)
 }
 
-func (c *CoerceStringSchema) Min(minLength int) *CoerceStringSchema {
-	c.Inner.Min(minLength)
+func (c *CoerceStringSchema) ParseTypedState(value string, state *core.State) *core.Result[string] {
+	return c.Inner.ParseTypedState(value, state)
+}
+
+func (c *CoerceStringSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return c.Parse(value).ToAny()
+}
+
+func (c *CoerceStringSchema) Describe() *core.Description {
+	return c.Inner.Describe()
+}
+
+func (c *CoerceStringSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return c.ParseState(value, state).ToAny()
+}
+
+func (c *CoerceStringSchema) Default(value string) *core.DefaultSchema[string] {
+	return core.NewDefaultSchema[string](c, func() string { return value })
+}
+
+func (c *CoerceStringSchema) DefaultFunc(defaultValue func() string) *core.DefaultSchema[string] {
+	return core.NewDefaultSchema[string](c, defaultValue)
+}
+
+func (c *CoerceStringSchema) Catch(fallback string) *core.CatchSchema[string] {
+	return core.NewCatchSchema[string](c, fallback)
+}
+
+func (c *CoerceStringSchema) Refine(check func(string) bool, message string, opts ...core.RefineOptions) *CoerceStringSchema {
+	c.Inner.Schema.Refine(check, message, opts...)
+	return c
+}
+
+func (c *CoerceStringSchema) SuperRefine(refinement func(value string, ctx *core.RefinementCtx)) *CoerceStringSchema {
+	c.Inner.Schema.SuperRefine(refinement)
+	return c
+}
+
+func (c *CoerceStringSchema) RefineContext(check func(ctx context.Context, value string) error) *CoerceStringSchema {
+	c.Inner.Schema.RefineContext(check)
+	return c
+}
+
+func (c *CoerceStringSchema) Messages(messageFunc core.MessageFunc) *CoerceStringSchema {
+	c.Inner.Schema.MessageFunc = messageFunc
+	return c
+}
+
+func (c *CoerceStringSchema) FailFast() *CoerceStringSchema {
+	c.Inner.Schema.FailFast = true
+	return c
+}
+
+func (c *CoerceStringSchema) Description(text string) *CoerceStringSchema {
+	c.Inner.Schema.Doc = text
+	return c
+}
+
+func (c *CoerceStringSchema) Example(value string) *CoerceStringSchema {
+	c.Inner.Schema.Examples = append(c.Inner.Schema.Examples, value)
+	return c
+}
+
+func (c *CoerceStringSchema) Then() *CoerceStringSchema {
+	c.Inner.Schema.Then()
+	return c
+}
+
+func (c *CoerceStringSchema) Exists(lookup core.Lookup[string], message ...string) *CoerceStringSchema {
+	c.Inner.Exists(lookup, message...)
+	return c
+}
+
+func (c *CoerceStringSchema) NotExists(lookup core.Lookup[string], message ...string) *CoerceStringSchema {
+	c.Inner.NotExists(lookup, message...)
+	return c
+}
+
+func (c *CoerceStringSchema) Min(minLength int, message ...string) *CoerceStringSchema {
+	c.Inner.Min(minLength, message...)
 	return c
 }
 
-func (c *CoerceStringSchema) Max(maxLength int) *CoerceStringSchema {
-	c.Inner.Max(maxLength)
+func (c *CoerceStringSchema) Max(maxLength int, message ...string) *CoerceStringSchema {
+	c.Inner.Max(maxLength, message...)
 	return c
 }
 
-func (c *CoerceStringSchema) Length(length int) *CoerceStringSchema {
-	c.Inner.Length(length)
+func (c *CoerceStringSchema) Length(length int, message ...string) *CoerceStringSchema {
+	c.Inner.Length(length, message...)
 	return c
 }
 
-func (c *CoerceStringSchema) Email() *CoerceStringSchema {
-	c.Inner.Email()
+func (c *CoerceStringSchema) Email(message ...string) *CoerceStringSchema {
+	c.Inner.Email(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) URL() *CoerceStringSchema {
-	c.Inner.URL()
+func (c *CoerceStringSchema) URL(message ...string) *CoerceStringSchema {
+	c.Inner.URL(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) Regex(regex *regexp.Regexp) *CoerceStringSchema {
-	c.Inner.Regex(regex)
+func (c *CoerceStringSchema) Regex(regex *regexp.Regexp, message ...string) *CoerceStringSchema {
+	c.Inner.Regex(regex, message...)
 	return c
 }
 
-func (c *CoerceStringSchema) Includes(substr string) *CoerceStringSchema {
-	c.Inner.Includes(substr)
+func (c *CoerceStringSchema) Includes(substr string, message ...string) *CoerceStringSchema {
+	c.Inner.Includes(substr, message...)
 	return c
 }
 
-func (c *CoerceStringSchema) StartsWith(prefix string) *CoerceStringSchema {
-	c.Inner.StartsWith(prefix)
+func (c *CoerceStringSchema) StartsWith(prefix string, message ...string) *CoerceStringSchema {
+	c.Inner.StartsWith(prefix, message...)
 	return c
 }
 
-func (c *CoerceStringSchema) EndsWith(suffix string) *CoerceStringSchema {
-	c.Inner.EndsWith(suffix)
+func (c *CoerceStringSchema) EndsWith(suffix string, message ...string) *CoerceStringSchema {
+	c.Inner.EndsWith(suffix, message...)
 	return c
 }
 
-func (c *CoerceStringSchema) Date() *CoerceStringSchema {
-	c.Inner.Date()
+func (c *CoerceStringSchema) Date(message ...string) *CoerceStringSchema {
+	c.Inner.Date(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) Time() *CoerceStringSchema {
-	c.Inner.Time()
+func (c *CoerceStringSchema) Time(message ...string) *CoerceStringSchema {
+	c.Inner.Time(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) IP() *CoerceStringSchema {
-	c.Inner.IP()
+func (c *CoerceStringSchema) IP(message ...string) *CoerceStringSchema {
+	c.Inner.IP(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) CIDR() *CoerceStringSchema {
-	c.Inner.CIDR()
+func (c *CoerceStringSchema) CIDR(message ...string) *CoerceStringSchema {
+	c.Inner.CIDR(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) UUID() *CoerceStringSchema {
-	c.Inner.UUID()
+func (c *CoerceStringSchema) UUID(message ...string) *CoerceStringSchema {
+	c.Inner.UUID(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) NanoID() *CoerceStringSchema {
-	c.Inner.NanoID()
+func (c *CoerceStringSchema) NanoID(message ...string) *CoerceStringSchema {
+	c.Inner.NanoID(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) CUID() *CoerceStringSchema {
-	c.Inner.CUID()
+func (c *CoerceStringSchema) CUID(message ...string) *CoerceStringSchema {
+	c.Inner.CUID(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) CUID2() *CoerceStringSchema {
-	c.Inner.CUID2()
+func (c *CoerceStringSchema) CUID2(message ...string) *CoerceStringSchema {
+	c.Inner.CUID2(message...)
 	return c
 }
 
-func (c *CoerceStringSchema) ULID() *CoerceStringSchema {
-	c.Inner.ULID()
+func (c *CoerceStringSchema) ULID(message ...string) *CoerceStringSchema {
+	c.Inner.ULID(message...)
 	return c
 }
@@ -1,6 +1,7 @@
 package composites
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -9,10 +10,22 @@ import (
 
 type ArraySchema[T any] struct {
 	Schema *core.Schema[[]T]
-	Inner  *core.Schema[T]
+	Inner  core.Parser[T]
+	// Workers is the number of goroutines parsing elements, where zero and
+	// one both mean the elements are parsed sequentially.
+	Workers int
+
+	uniqueKeys []uniqueKey[T]
+}
+
+// uniqueKey is a key added by Unique or UniqueBy. Duplicates are found while
+// parsing the elements, so that they are reported at their input index.
+type uniqueKey[T any] struct {
+	key     func(T) interface{}
+	message []string
 }
 
-func NewArraySchema[T any](path string, inner *core.Schema[T]) *ArraySchema[T] {
+func NewArraySchema[T any](path string, inner core.Parser[T]) *ArraySchema[T] {
 	return &ArraySchema[T]{
 		Schema: &core.Schema[[]T]{
 			Path:  path,
@@ -23,104 +36,298 @@ func NewArraySchema[T any](path string, inner *core.Schema[T]) *ArraySchema[T] {
 }
 
 func (s *ArraySchema[T]) Parse(value interface{}) *core.Result[[]T] {
-	v := reflect.ValueOf(value)
-	if v.Kind() != reflect.Slice {
-		return s.Schema.NewErrorResult("Must be an array")
-	}
-
-	var parsedArray []T
-	finalResult := s.Schema.NewSuccessResult()
+	return s.ParseState(value, nil)
+}
 
-	for i := 0; i < v.Len(); i++ {
-		element := v.Index(i).Interface()
-		parsedValue, ok := element.(T)
+func (s *ArraySchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[[]T] {
+	return core.ParseContext[[]T](ctx, s, value)
+}
 
-		if !ok {
-			finalResult.Ok = false
-			finalResult.Errors = append(finalResult.Errors, fmt.Sprintf("Element at index %d must be of type %T", i, parsedValue))
-			continue
+func (s *ArraySchema[T]) ParseState(value interface{}, state *core.State) *core.Result[[]T] {
+	elements, isInterfaceSlice := value.([]interface{})
+	if !isInterfaceSlice {
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Slice {
+			return s.Schema.NewTypeErrorResult(value, "array", "Must be an array")
 		}
 
-		innerResult := s.Inner.ParseGeneric(parsedValue)
-		if !innerResult.Ok {
-			finalResult.Ok = false
-			finalResult.Errors = append(finalResult.Errors, innerResult.Errors...)
-		} else {
-			parsedArray = append(parsedArray, parsedValue)
+		elements = make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elements[i] = v.Index(i).Interface()
 		}
 	}
 
-	baseResult := s.Schema.ParseGeneric(parsedArray)
-	if !baseResult.Ok {
-		finalResult.Ok = false
-		finalResult.Errors = append(finalResult.Errors, baseResult.Errors...)
-	}
-
-	finalResult.Value = parsedArray
-	return finalResult
+	return parseArray(s, elements, state, func(element interface{}) *core.Result[T] {
+		return core.ParseState(s.Inner, element, state)
+	})
 }
 
 func (s *ArraySchema[T]) ParseTyped(value []T) *core.Result[[]T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *ArraySchema[T]) ParseTypedState(value []T, state *core.State) *core.Result[[]T] {
+	return parseArray(s, value, state, func(element T) *core.Result[T] {
+		return core.ParseTypedState(s.Inner, element, state)
+	})
+}
+
+func parseArray[T any, E any](s *ArraySchema[T], elements []E, state *core.State, parseElement func(E) *core.Result[T]) *core.Result[[]T] {
+	parsedArray := make([]T, 0, len(elements))
+	indexes := make([]int, 0, len(elements))
 	finalResult := s.Schema.NewSuccessResult()
 
-	for i, v := range value {
-		innerResult := s.Inner.ParseGeneric(v)
+	results := parseAll(len(elements), s.Workers, state, func(i int) *core.Result[T] {
+		return parseElement(elements[i])
+	})
+
+	for i, innerResult := range results {
 		if !innerResult.Ok {
-			errorMessage := fmt.Sprintf("Element at index %d: %s", i, innerResult.Errors)
-			finalResult.Ok = false
-			finalResult.Errors = append(finalResult.Errors, errorMessage)
+			finalResult.AddIssues(core.PrependPath(innerResult.Issues, i)...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+			continue
 		}
+
+		parsedArray = append(parsedArray, innerResult.Value)
+		indexes = append(indexes, i)
 	}
 
-	baseResult := s.Schema.ParseGeneric(value)
-	if !baseResult.Ok {
-		finalResult.Ok = false
-		finalResult.Errors = append(finalResult.Errors, baseResult.Errors...)
+	for _, unique := range s.uniqueKeys {
+		for _, duplicate := range findDuplicates(len(parsedArray), func(i int) interface{} { return unique.key(parsedArray[i]) }) {
+			index, duplicateOf := indexes[duplicate.index], indexes[duplicate.duplicateOf]
+			finalResult.AddIssues(s.Schema.NewIssueResult(parsedArray[duplicate.index], core.Issue{
+				Code:    core.NotUnique,
+				Path:    core.Path{index},
+				Params:  core.Params{"duplicate_of": duplicateOf},
+				Message: fmt.Sprintf("Duplicate of element at index %d", duplicateOf),
+			}, unique.message...).Issues...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+		}
 	}
 
-	finalResult.Value = value
+	baseResult := s.Schema.ParseGenericState(parsedArray, state)
+	finalResult.AddIssues(baseResult.Issues...)
+
+	finalResult.Value = parsedArray
 	return finalResult
 }
 
-func (s *ArraySchema[T]) Nonempty() *ArraySchema[T] {
-	s.Schema.AddRule(func(value []T) *core.Result[[]T] {
+func (s *ArraySchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *ArraySchema[T]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindArray)
+	description.Element = core.Describe(s.Inner)
+	return description
+}
+
+func (s *ArraySchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *ArraySchema[T]) Default(value []T) *core.DefaultSchema[[]T] {
+	return core.NewDefaultSchema[[]T](s, func() []T { return value })
+}
+
+func (s *ArraySchema[T]) DefaultFunc(defaultValue func() []T) *core.DefaultSchema[[]T] {
+	return core.NewDefaultSchema[[]T](s, defaultValue)
+}
+
+func (s *ArraySchema[T]) Catch(fallback []T) *core.CatchSchema[[]T] {
+	return core.NewCatchSchema[[]T](s, fallback)
+}
+
+func (s *ArraySchema[T]) Refine(check func([]T) bool, message string, opts ...core.RefineOptions) *ArraySchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *ArraySchema[T]) SuperRefine(refinement func(value []T, ctx *core.RefinementCtx)) *ArraySchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *ArraySchema[T]) RefineContext(check func(ctx context.Context, value []T) error) *ArraySchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *ArraySchema[T]) Messages(messageFunc core.MessageFunc) *ArraySchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+// Parallel parses the elements on a pool of workers, or on one worker per CPU
+// when workers is below one. Issues are still reported in index order.
+func (s *ArraySchema[T]) Parallel(workers int) *ArraySchema[T] {
+	s.Workers = parallelism(workers)
+	return s
+}
+
+func (s *ArraySchema[T]) FailFast() *ArraySchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *ArraySchema[T]) Description(text string) *ArraySchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *ArraySchema[T]) Example(value []T) *ArraySchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *ArraySchema[T]) Then() *ArraySchema[T] {
+	s.Schema.Then()
+	return s
+}
+
+func (s *ArraySchema[T]) Nonempty(message ...string) *ArraySchema[T] {
+	params := core.Params{"type": "array", "min": 1, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value []T) *core.Result[[]T] {
 		if len(value) == 0 {
-			return s.Schema.NewErrorResult("Array must not be empty")
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: "Array must not be empty",
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *ArraySchema[T]) Min(minLength int) *ArraySchema[T] {
-	s.Schema.AddRule(func(value []T) *core.Result[[]T] {
+func (s *ArraySchema[T]) Min(minLength int, message ...string) *ArraySchema[T] {
+	params := core.Params{"type": "array", "min": minLength, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value []T) *core.Result[[]T] {
 		if len(value) < minLength {
-			errorMessage := fmt.Sprintf("Array must have at least %d elements", minLength)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: fmt.Sprintf("Array must have at least %d elements", minLength),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *ArraySchema[T]) Max(maxLength int) *ArraySchema[T] {
-	s.Schema.AddRule(func(value []T) *core.Result[[]T] {
+func (s *ArraySchema[T]) Max(maxLength int, message ...string) *ArraySchema[T] {
+	params := core.Params{"type": "array", "max": maxLength, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value []T) *core.Result[[]T] {
 		if len(value) > maxLength {
-			errorMessage := fmt.Sprintf("Array must have at most %d elements", maxLength)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: fmt.Sprintf("Array must have at most %d elements", maxLength),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *ArraySchema[T]) Length(exactLength int) *ArraySchema[T] {
-	s.Schema.AddRule(func(value []T) *core.Result[[]T] {
+func (s *ArraySchema[T]) Length(exactLength int, message ...string) *ArraySchema[T] {
+	params := core.Params{"type": "array", "length": exactLength}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidLength, Params: params}, func(value []T) *core.Result[[]T] {
 		if len(value) != exactLength {
-			errorMessage := fmt.Sprintf("Array must have exactly %d elements", exactLength)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.InvalidLength,
+				Params:  params,
+				Message: fmt.Sprintf("Array must have exactly %d elements", exactLength),
+			}, message...)
+		}
+		return s.Schema.NewSuccessResult()
+	})
+	return s
+}
+
+func (s *ArraySchema[T]) Unique(message ...string) *ArraySchema[T] {
+	s.Schema.Checks = append(s.Schema.Checks, core.Check{Code: core.NotUnique})
+	s.uniqueKeys = append(s.uniqueKeys, uniqueKey[T]{func(element T) interface{} { return element }, message})
+	return s
+}
+
+// UniqueBy rejects elements whose key is equal to the key of an earlier
+// element, reporting an issue at the index of every duplicate.
+func (s *ArraySchema[T]) UniqueBy(key func(T) interface{}, message ...string) *ArraySchema[T] {
+	s.Schema.Checks = append(s.Schema.Checks, core.Check{Code: core.NotUnique})
+	s.uniqueKeys = append(s.uniqueKeys, uniqueKey[T]{key, message})
+	return s
+}
+
+func (s *ArraySchema[T]) Contains(element T, message ...string) *ArraySchema[T] {
+	params := core.Params{"element": element}
+	s.Schema.AddCheck(core.Check{Code: core.MissingElement, Params: params}, func(value []T) *core.Result[[]T] {
+		if !containsElement(value, element) {
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.MissingElement,
+				Params:  params,
+				Message: fmt.Sprintf("Must contain %v", element),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
+
+func (s *ArraySchema[T]) ContainsAll(elements ...T) *ArraySchema[T] {
+	for _, element := range elements {
+		s.Contains(element)
+	}
+	return s
+}
+
+type duplicate struct {
+	index       int
+	duplicateOf int
+}
+
+// findDuplicates compares the keys of n elements, hashing comparable keys and
+// falling back to deep equality for the others.
+func findDuplicates(n int, keyAt func(int) interface{}) []duplicate {
+	var duplicates []duplicate
+	seen := make(map[interface{}]int, n)
+	var uncomparable []int
+
+	for i := 0; i < n; i++ {
+		key := keyAt(i)
+		if key == nil || reflect.TypeOf(key).Comparable() && reflect.ValueOf(key).Comparable() {
+			if first, exists := seen[key]; exists {
+				duplicates = append(duplicates, duplicate{index: i, duplicateOf: first})
+			} else {
+				seen[key] = i
+			}
+			continue
+		}
+
+		found := false
+		for _, j := range uncomparable {
+			if reflect.DeepEqual(keyAt(j), key) {
+				duplicates = append(duplicates, duplicate{index: i, duplicateOf: j})
+				found = true
+				break
+			}
+		}
+		if !found {
+			uncomparable = append(uncomparable, i)
+		}
+	}
+	return duplicates
+}
+
+func containsElement[T any](elements []T, element T) bool {
+	for _, candidate := range elements {
+		if reflect.DeepEqual(candidate, element) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,16 +1,20 @@
 package composites_test
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/coercion"
 	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/literals"
 	"github.com/abyanmajid/v/internal/primitives"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewArraySchema(t *testing.T) {
-	innerSchema := &core.Schema[int]{}
+	innerSchema := primitives.NewNumberSchema[int]("hi")
 	arraySchema := composites.NewArraySchema("bruh", innerSchema)
 
 	assert.NotNil(t, arraySchema)
@@ -19,7 +23,7 @@ func TestNewArraySchema(t *testing.T) {
 }
 
 func TestArraySchema_Parse(t *testing.T) {
-	innerSchema := primitives.NewNumberSchema[int]("hi").Schema
+	innerSchema := primitives.NewNumberSchema[int]("hi")
 	arraySchema := composites.NewArraySchema("bruh", innerSchema)
 
 	t.Run("Valid array", func(t *testing.T) {
@@ -31,7 +35,7 @@ func TestArraySchema_Parse(t *testing.T) {
 	t.Run("Invalid array element type", func(t *testing.T) {
 		result := arraySchema.Parse([]interface{}{1, "two", 3})
 		assert.False(t, result.Ok)
-		assert.Contains(t, result.Errors, "Element at index 1 must be of type int")
+		assert.Contains(t, result.Errors, "Element at index 1: Must be a number.")
 	})
 
 	t.Run("Not an array", func(t *testing.T) {
@@ -42,7 +46,7 @@ func TestArraySchema_Parse(t *testing.T) {
 }
 
 func TestArraySchema_ParseTyped(t *testing.T) {
-	innerSchema := primitives.NewNumberSchema[int]("hi").Schema
+	innerSchema := primitives.NewNumberSchema[int]("hi")
 	arraySchema := composites.NewArraySchema("bruh", innerSchema)
 
 	t.Run("Valid typed array", func(t *testing.T) {
@@ -53,7 +57,7 @@ func TestArraySchema_ParseTyped(t *testing.T) {
 }
 
 func TestArraySchema_Nonempty(t *testing.T) {
-	innerSchema := primitives.NewNumberSchema[int]("hi").Schema
+	innerSchema := primitives.NewNumberSchema[int]("hi")
 	arraySchema := composites.NewArraySchema("bruh", innerSchema).Nonempty()
 
 	t.Run("Non-empty array", func(t *testing.T) {
@@ -69,7 +73,7 @@ func TestArraySchema_Nonempty(t *testing.T) {
 }
 
 func TestArraySchema_Min(t *testing.T) {
-	innerSchema := primitives.NewNumberSchema[int]("hi").Schema
+	innerSchema := primitives.NewNumberSchema[int]("hi")
 	arraySchema := composites.NewArraySchema("bruh", innerSchema).Min(2)
 
 	t.Run("Array meets min length", func(t *testing.T) {
@@ -85,7 +89,7 @@ func TestArraySchema_Min(t *testing.T) {
 }
 
 func TestArraySchema_Max(t *testing.T) {
-	innerSchema := primitives.NewNumberSchema[int]("hi").Schema
+	innerSchema := primitives.NewNumberSchema[int]("hi")
 	arraySchema := composites.NewArraySchema("bruh", innerSchema).Max(2)
 
 	t.Run("Array meets max length", func(t *testing.T) {
@@ -101,7 +105,7 @@ func TestArraySchema_Max(t *testing.T) {
 }
 
 func TestArraySchema_Length(t *testing.T) {
-	innerSchema := primitives.NewNumberSchema[int]("hi").Schema
+	innerSchema := primitives.NewNumberSchema[int]("hi")
 	arraySchema := composites.NewArraySchema("bruh", innerSchema).Length(2)
 
 	t.Run("Array meets exact length", func(t *testing.T) {
@@ -115,3 +119,206 @@ func TestArraySchema_Length(t *testing.T) {
 		assert.Contains(t, result.Errors, "Array must have exactly 2 elements")
 	})
 }
+
+func TestArraySchema_InnerParsers(t *testing.T) {
+	t.Run("Array of coerced values", func(t *testing.T) {
+		arraySchema := composites.NewArraySchema("Ages", coercion.NewCoerceNumberSchema[int]("Age").Gte(18))
+
+		result := arraySchema.Parse([]interface{}{"18", 42, "not a number", "12"})
+		assert.False(t, result.Ok)
+		assert.Equal(t, []int{18, 42}, result.Value)
+		assert.Equal(t, []string{
+			"Element at index 2: Must be a value that can be casted to a number",
+			"Element at index 3: Must be greater than or equal to 18",
+		}, result.Errors)
+	})
+
+	t.Run("Array of enums", func(t *testing.T) {
+		arraySchema := composites.NewArraySchema("Universities", literals.NewEnumSchema("University", []string{"USYD", "UNSW"}))
+
+		result := arraySchema.Parse([]interface{}{"USYD", "MIT"})
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{"Element at index 1: Value is not in the allowed enum set."}, result.Errors)
+
+		result = arraySchema.ParseTyped([]string{"UNSW", "MIT"})
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{"Element at index 1: Value is not in the allowed enum set."}, result.Errors)
+	})
+
+	t.Run("Nested arrays", func(t *testing.T) {
+		matrix := composites.NewArraySchema("Matrix", composites.NewArraySchema("Row", primitives.NewNumberSchema[int]("Cell").Positive()).Length(2))
+
+		result := matrix.Parse([]interface{}{[]interface{}{1, 2}, []int{3, 4}})
+		assert.True(t, result.Ok)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}}, result.Value)
+
+		result = matrix.Parse([]interface{}{[]int{1, -2}, []int{3}})
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{
+			"Element at index 0: Element at index 1: Must be a positive number",
+			"Element at index 0: Array must have exactly 2 elements",
+			"Element at index 1: Array must have exactly 2 elements",
+		}, result.Errors)
+	})
+}
+
+func TestArraySchema_Unique(t *testing.T) {
+	schema := composites.NewArraySchema[string]("Tags", primitives.NewStringSchema("Tag")).Unique()
+
+	assert.True(t, schema.Parse([]interface{}{"go", "rust"}).Ok)
+
+	result := schema.Parse([]interface{}{"go", "rust", "go", "go"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{
+		"Element at index 2: Duplicate of element at index 0",
+		"Element at index 3: Duplicate of element at index 0",
+	}, result.Errors)
+	assert.Equal(t, core.NotUnique, result.Issues[0].Code)
+	assert.Equal(t, core.Params{"duplicate_of": 0}, result.Issues[0].Params)
+	assert.Equal(t, "string", result.Issues[0].Received)
+
+	// Duplicates are reported at their input index even when an earlier
+	// element failed.
+	result = schema.Parse([]interface{}{"a", 1, "b", "b"})
+	assert.Equal(t, []string{
+		"Element at index 1: Must be a string.",
+		"Element at index 3: Duplicate of element at index 2",
+	}, result.Errors)
+	assert.Equal(t, core.Params{"duplicate_of": 2}, result.Issues[1].Params)
+
+	// The rules of the array run on the elements that passed.
+	result = composites.NewArraySchema[string]("Tags", primitives.NewStringSchema("Tag")).Min(2).Parse([]interface{}{"go", 1})
+	assert.Equal(t, []string{"Element at index 1: Must be a string.", "Array must have at least 2 elements"}, result.Errors)
+}
+
+func TestArraySchema_UniqueBy(t *testing.T) {
+	type item struct {
+		ID   int
+		Tags []string
+	}
+
+	byID := composites.NewArraySchema[item]("Items", composites.NewStructSchema[item]("Item")).
+		UniqueBy(func(value item) interface{} { return value.ID }, "Item {value} is listed twice")
+
+	result := byID.ParseTyped([]item{{ID: 1}, {ID: 2}, {ID: 1, Tags: []string{"x"}}})
+	assert.Equal(t, []string{"Element at index 2: Item {1 [x]} is listed twice"}, result.Errors)
+	assert.Equal(t, []core.Check{{Code: core.NotUnique}}, byID.Describe().Checks)
+
+	// Uncomparable elements are compared deeply.
+	schema := composites.NewArraySchema[interface{}]("Items", primitives.NewAnySchema("Item")).Unique()
+	anyResult := schema.Parse([]interface{}{[]int{1}, []int{2}, []int{1}, 1})
+	assert.Equal(t, []string{"Element at index 2: Duplicate of element at index 0"}, anyResult.Errors)
+}
+
+func TestArraySchema_Contains(t *testing.T) {
+	schema := composites.NewArraySchema[string]("Roles", primitives.NewStringSchema("Role")).
+		Contains("member").
+		ContainsAll("reader", "writer")
+
+	assert.True(t, schema.Parse([]interface{}{"writer", "member", "reader"}).Ok)
+
+	result := schema.Parse([]interface{}{"reader"})
+	assert.Equal(t, []string{"Must contain member", "Must contain writer"}, result.Errors)
+	assert.Equal(t, core.MissingElement, result.Issues[0].Code)
+	assert.Equal(t, core.Params{"element": "member"}, result.Issues[0].Params)
+}
+
+func TestArraySchema_AbortEarly(t *testing.T) {
+	schema := composites.NewArraySchema[int]("scores", primitives.NewNumberSchema[int]("score").Gte(0)).Unique()
+
+	scores := make([]interface{}, 10000)
+	for i := range scores {
+		scores[i] = -1
+	}
+
+	result := core.ParseWith[[]int](schema, scores, core.ParseOptions{AbortEarly: true})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, core.Path{0}, result.Issues[0].Path)
+
+	result = schema.Parse(scores)
+	assert.Len(t, result.Issues, 10000)
+}
+
+func TestArraySchema_Parallel(t *testing.T) {
+	newSchema := func() *composites.ArraySchema[int] {
+		return composites.NewArraySchema[int]("scores", primitives.NewNumberSchema[int]("score").Gte(0).MultipleOf(2))
+	}
+
+	scores := make([]interface{}, 1000)
+	for i := range scores {
+		scores[i] = i
+		if i%7 == 3 {
+			scores[i] = -i
+		}
+	}
+
+	sequential := newSchema().Parse(scores)
+	parallel := newSchema().Parallel(8).Parse(scores)
+	assert.False(t, parallel.Ok)
+	assert.Equal(t, sequential.Issues, parallel.Issues)
+	assert.Equal(t, sequential.Errors, parallel.Errors)
+
+	for i := range scores {
+		scores[i] = 2 * i
+	}
+	result := newSchema().Parallel(0).Parse(scores)
+	assert.True(t, result.Ok)
+	assert.Len(t, result.Value, 1000)
+	assert.Equal(t, 1998, result.Value[999])
+
+	scores[700], scores[500] = -1, -1
+	for i := 0; i < 20; i++ {
+		result = core.ParseWith[[]int](newSchema().Parallel(8), scores, core.ParseOptions{AbortEarly: true})
+		assert.Len(t, result.Issues, 1)
+		assert.Equal(t, core.Path{500}, result.Issues[0].Path)
+	}
+}
+
+func newBenchmarkRecords(n int) []interface{} {
+	records := make([]interface{}, n)
+	for i := range records {
+		records[i] = map[string]interface{}{
+			"name":  fmt.Sprintf("Applicant %d", i),
+			"email": fmt.Sprintf("applicant%d@example.com", i),
+			"wam":   float64(50 + i%50),
+			"courseworks": []interface{}{
+				"COMP1511", "COMP2521", "MATH1131",
+			},
+		}
+	}
+	return records
+}
+
+func newBenchmarkSchema() *composites.ArraySchema[map[string]interface{}] {
+	return composites.NewArraySchema[map[string]interface{}]("applicants", composites.NewObjectSchema("applicant", composites.Shape{
+		"name":        primitives.NewStringSchema("name").Min(1).Max(128),
+		"email":       primitives.NewStringSchema("email").Email(),
+		"wam":         primitives.NewNumberSchema[float64]("wam").Gte(0).Lte(100),
+		"courseworks": composites.NewArraySchema[string]("courseworks", primitives.NewStringSchema("coursework").Regex(regexp.MustCompile(`^[A-Z]{4}\d{4}$`))).Min(1),
+	}))
+}
+
+// BenchmarkArraySchema_Parse compares parsing 100k records sequentially with
+// parsing them on one worker per CPU; run it with -cpu to vary the CPU count.
+func BenchmarkArraySchema_Parse(b *testing.B) {
+	records := newBenchmarkRecords(100000)
+
+	b.Run("Sequential", func(b *testing.B) {
+		schema := newBenchmarkSchema()
+		for i := 0; i < b.N; i++ {
+			if !schema.Parse(records).Ok {
+				b.Fatal("expected records to be valid")
+			}
+		}
+	})
+
+	b.Run("Parallel", func(b *testing.B) {
+		schema := newBenchmarkSchema().Parallel(0)
+		for i := 0; i < b.N; i++ {
+			if !schema.Parse(records).Ok {
+				b.Fatal("expected records to be valid")
+			}
+		}
+	})
+}
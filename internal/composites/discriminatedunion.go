@@ -0,0 +1,204 @@
+package composites
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/literals"
+)
+
+type DiscriminatedUnionSchema[K comparable] struct {
+	Schema        *core.Schema[map[string]interface{}]
+	Discriminator string
+	Options       map[K]*ObjectSchema
+	allowed       []K
+}
+
+// NewDiscriminatedUnionSchema dispatches on the value of the discriminator
+// key. Options that don't declare the key get a literal schema for it, so the
+// parsed object always carries its discriminator.
+func NewDiscriminatedUnionSchema[K comparable](path string, discriminator string, options map[K]*ObjectSchema) *DiscriminatedUnionSchema[K] {
+	s := &DiscriminatedUnionSchema[K]{
+		Schema: &core.Schema[map[string]interface{}]{
+			Path:  path,
+			Rules: []core.Rule[map[string]interface{}]{},
+		},
+		Discriminator: discriminator,
+		Options:       make(map[K]*ObjectSchema, len(options)),
+	}
+
+	for discriminatorValue, option := range options {
+		if _, declared := option.Shape[discriminator]; !declared {
+			shape := make(Shape, len(option.Shape)+1)
+			for key, fieldSchema := range option.Shape {
+				shape[key] = fieldSchema
+			}
+			shape[discriminator] = literals.NewLiteralSchema(discriminator, discriminatorValue)
+
+			extended := *option
+			extended.Shape = shape
+			option = &extended
+		}
+
+		s.Options[discriminatorValue] = option
+		s.allowed = append(s.allowed, discriminatorValue)
+	}
+
+	sort.Slice(s.allowed, func(i, j int) bool {
+		return fmt.Sprint(s.allowed[i]) < fmt.Sprint(s.allowed[j])
+	})
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) Parse(value interface{}) *core.Result[map[string]interface{}] {
+	return s.ParseState(value, nil)
+}
+
+func (s *DiscriminatedUnionSchema[K]) ParseContext(ctx context.Context, value interface{}) *core.Result[map[string]interface{}] {
+	return core.ParseContext[map[string]interface{}](ctx, s, value)
+}
+
+func (s *DiscriminatedUnionSchema[K]) ParseState(value interface{}, state *core.State) *core.Result[map[string]interface{}] {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return s.Schema.NewTypeErrorResult(value, "object", "Must be an object")
+	}
+
+	discriminator := v.MapIndex(reflect.ValueOf(s.Discriminator).Convert(v.Type().Key()))
+	if !discriminator.IsValid() {
+		return s.missingDiscriminator(value)
+	}
+
+	option, known := s.option(discriminator.Interface())
+	if !known {
+		return s.unknownDiscriminator(value, discriminator.Interface())
+	}
+	return s.finish(option.ParseState(value, state), state)
+}
+
+func (s *DiscriminatedUnionSchema[K]) ParseTyped(value map[string]interface{}) *core.Result[map[string]interface{}] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *DiscriminatedUnionSchema[K]) ParseTypedState(value map[string]interface{}, state *core.State) *core.Result[map[string]interface{}] {
+	discriminator, present := value[s.Discriminator]
+	if !present {
+		return s.missingDiscriminator(value)
+	}
+
+	option, known := s.option(discriminator)
+	if !known {
+		return s.unknownDiscriminator(value, discriminator)
+	}
+	return s.finish(option.ParseTypedState(value, state), state)
+}
+
+func (s *DiscriminatedUnionSchema[K]) option(discriminator interface{}) (*ObjectSchema, bool) {
+	key, isKey := discriminator.(K)
+	if !isKey {
+		return nil, false
+	}
+	option, known := s.Options[key]
+	return option, known
+}
+
+// missingDiscriminator reports an absent discriminator as required, so that
+// it can be told apart from an unknown one.
+func (s *DiscriminatedUnionSchema[K]) missingDiscriminator(value interface{}) *core.Result[map[string]interface{}] {
+	return s.Schema.NewIssueResult(value, core.Issue{
+		Code:     core.Required,
+		Path:     core.Path{s.Discriminator},
+		Params:   core.Params{"key": s.Discriminator, "allowed": s.allowed},
+		Received: "absent",
+		Message:  fmt.Sprintf("Missing discriminator, expected one of %v", s.allowed),
+	})
+}
+
+func (s *DiscriminatedUnionSchema[K]) unknownDiscriminator(value interface{}, discriminator interface{}) *core.Result[map[string]interface{}] {
+	return s.Schema.NewIssueResult(value, core.Issue{
+		Code:     core.InvalidDiscriminator,
+		Path:     core.Path{s.Discriminator},
+		Params:   core.Params{"key": s.Discriminator, "allowed": s.allowed},
+		Received: core.TypeName(discriminator),
+		Message:  fmt.Sprintf("Unknown discriminator %v, expected one of %v", discriminator, s.allowed),
+	})
+}
+
+func (s *DiscriminatedUnionSchema[K]) finish(optionResult *core.Result[map[string]interface{}], state *core.State) *core.Result[map[string]interface{}] {
+	if !optionResult.Ok {
+		return optionResult
+	}
+	return s.Schema.ParseGenericState(optionResult.Value, state)
+}
+
+func (s *DiscriminatedUnionSchema[K]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *DiscriminatedUnionSchema[K]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindUnion)
+	description.Discriminator = s.Discriminator
+	for _, discriminator := range s.allowed {
+		description.Options = append(description.Options, s.Options[discriminator].Describe())
+	}
+	return description
+}
+
+func (s *DiscriminatedUnionSchema[K]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *DiscriminatedUnionSchema[K]) Default(value map[string]interface{}) *core.DefaultSchema[map[string]interface{}] {
+	return core.NewDefaultSchema[map[string]interface{}](s, func() map[string]interface{} { return value })
+}
+
+func (s *DiscriminatedUnionSchema[K]) DefaultFunc(defaultValue func() map[string]interface{}) *core.DefaultSchema[map[string]interface{}] {
+	return core.NewDefaultSchema[map[string]interface{}](s, defaultValue)
+}
+
+func (s *DiscriminatedUnionSchema[K]) Catch(fallback map[string]interface{}) *core.CatchSchema[map[string]interface{}] {
+	return core.NewCatchSchema[map[string]interface{}](s, fallback)
+}
+
+func (s *DiscriminatedUnionSchema[K]) Refine(check func(map[string]interface{}) bool, message string, opts ...core.RefineOptions) *DiscriminatedUnionSchema[K] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) SuperRefine(refinement func(value map[string]interface{}, ctx *core.RefinementCtx)) *DiscriminatedUnionSchema[K] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) RefineContext(check func(ctx context.Context, value map[string]interface{}) error) *DiscriminatedUnionSchema[K] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) Messages(messageFunc core.MessageFunc) *DiscriminatedUnionSchema[K] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) FailFast() *DiscriminatedUnionSchema[K] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) Description(text string) *DiscriminatedUnionSchema[K] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) Example(value map[string]interface{}) *DiscriminatedUnionSchema[K] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *DiscriminatedUnionSchema[K]) Then() *DiscriminatedUnionSchema[K] {
+	s.Schema.Then()
+	return s
+}
@@ -0,0 +1,89 @@
+package composites_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPaymentMethodSchema() *composites.DiscriminatedUnionSchema[string] {
+	return composites.NewDiscriminatedUnionSchema("PaymentMethod", "type", map[string]*composites.ObjectSchema{
+		"card": composites.NewObjectSchema("Card", composites.Shape{
+			"number": primitives.NewStringSchema("number").Length(16),
+		}),
+		"bank_transfer": composites.NewObjectSchema("BankTransfer", composites.Shape{
+			"iban": primitives.NewStringSchema("iban").Min(15),
+		}),
+	})
+}
+
+func TestDiscriminatedUnionSchema(t *testing.T) {
+	schema := newPaymentMethodSchema()
+
+	result := schema.Parse(map[string]interface{}{"type": "card", "number": "4242424242424242"})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]interface{}{"type": "card", "number": "4242424242424242"}, result.Value)
+
+	result = schema.Parse(map[string]interface{}{"type": "bank_transfer", "iban": "short"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"iban: Must be at least 15 characters long"}, result.Errors)
+
+	result = schema.Parse(map[string]interface{}{"type": "card", "iban": "DE89370400440532013000"})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 2)
+}
+
+func TestDiscriminatedUnionSchema_UnknownDiscriminator(t *testing.T) {
+	schema := newPaymentMethodSchema()
+
+	result := schema.Parse(map[string]interface{}{"type": "cash"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.InvalidDiscriminator, result.Issues[0].Code)
+	assert.Equal(t, "/type", result.Issues[0].Path.Pointer())
+	assert.Equal(t, []string{"bank_transfer", "card"}, result.Issues[0].Params["allowed"])
+	assert.Equal(t, "type: Unknown discriminator cash, expected one of [bank_transfer card]", result.Errors[0])
+
+	result = schema.Parse(map[string]interface{}{"number": "4242424242424242"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.Required, result.Issues[0].Code)
+	assert.Equal(t, "absent", result.Issues[0].Received)
+	assert.Equal(t, "type: Missing discriminator, expected one of [bank_transfer card]", result.Errors[0])
+	assert.Equal(t, []string{"type: Is required"}, result.Localize("en").Errors)
+
+	result = schema.Parse("card")
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+}
+
+func TestDiscriminatedUnionSchema_Localize(t *testing.T) {
+	result := newPaymentMethodSchema().Parse(map[string]interface{}{"type": 1}).Localize("en")
+	assert.Equal(t, []string{"type: Unknown discriminator, expected one of bank_transfer, card"}, result.Errors)
+}
+
+func TestDiscriminatedUnionSchema_ParseTyped(t *testing.T) {
+	schema := composites.NewDiscriminatedUnionSchema("Shape", "kind", map[string]*composites.ObjectSchema{
+		"circle": composites.NewObjectSchema("Circle", composites.Shape{
+			"radius": primitives.NewNumberSchema[int]("radius").Positive(),
+		}),
+	})
+
+	result := schema.Parse(map[string]interface{}{"kind": "circle", "radius": 2})
+	assert.True(t, result.Ok)
+	assert.Equal(t, result, schema.ParseTyped(result.Value))
+	assert.Equal(t, []string{"radius: Must be a positive number"}, schema.ParseTyped(map[string]interface{}{"kind": "circle", "radius": -2}).Errors)
+	assert.Equal(t, core.Required, schema.ParseTyped(map[string]interface{}{"radius": 2}).Issues[0].Code)
+	assert.Equal(t, core.InvalidDiscriminator, schema.ParseTyped(map[string]interface{}{"kind": "square"}).Issues[0].Code)
+}
+
+func TestDiscriminatedUnionSchema_KeepsOptionsIntact(t *testing.T) {
+	card := composites.NewObjectSchema("Card", composites.Shape{
+		"number": primitives.NewStringSchema("number"),
+	})
+	composites.NewDiscriminatedUnionSchema("PaymentMethod", "type", map[string]*composites.ObjectSchema{"card": card})
+
+	_, declared := card.Shape["type"]
+	assert.False(t, declared)
+}
@@ -0,0 +1,253 @@
+package composites
+
+import (
+	"context"
+	"reflect"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+type IntersectionSchema[T any] struct {
+	Schema *core.Schema[T]
+	Left   core.Parser[T]
+	Right  core.Parser[T]
+}
+
+func NewIntersectionSchema[T any](path string, left core.Parser[T], right core.Parser[T]) *IntersectionSchema[T] {
+	return &IntersectionSchema[T]{
+		Schema: &core.Schema[T]{
+			Path:  path,
+			Rules: []core.Rule[T]{},
+		},
+		Left:  left,
+		Right: right,
+	}
+}
+
+func (s *IntersectionSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *IntersectionSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, s, value)
+}
+
+func (s *IntersectionSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
+	left, right := s.operands()
+	leftResult := core.ParseState(left, value, state)
+	if !leftResult.Ok && state.AbortEarly() {
+		return s.intersect(value, leftResult, s.Schema.NewSuccessResult(), state)
+	}
+
+	return s.intersect(value, leftResult, core.ParseState(right, value, state), state)
+}
+
+func (s *IntersectionSchema[T]) ParseTyped(value T) *core.Result[T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *IntersectionSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	left, right := s.operands()
+	return s.intersect(value, core.ParseTypedState(left, value, state), core.ParseTypedState(right, value, state), state)
+}
+
+// operands returns the schemas both sides are parsed with. Two objects would
+// reject each other's keys, so they are parsed stripped and their unknown keys
+// policy is applied to the merged object instead.
+func (s *IntersectionSchema[T]) operands() (core.Parser[T], core.Parser[T]) {
+	left, _, leftIsObject := stripObject(s.Left)
+	right, _, rightIsObject := stripObject(s.Right)
+	if !leftIsObject || !rightIsObject {
+		return s.Left, s.Right
+	}
+	return left, right
+}
+
+func (s *IntersectionSchema[T]) objects() (*ObjectSchema, *ObjectSchema, bool) {
+	_, left, leftIsObject := stripObject(s.Left)
+	_, right, rightIsObject := stripObject(s.Right)
+	return left, right, leftIsObject && rightIsObject
+}
+
+// stripObject returns a stripped copy of an object schema, along with the
+// object schema itself. Objects wrapped in optional, default or lazy schemas
+// are stripped inside their wrappers.
+func stripObject[T any](parser core.Parser[T]) (core.Parser[T], *ObjectSchema, bool) {
+	switch schema := interface{}(parser).(type) {
+	case *ObjectSchema:
+		return interface{}(schema.stripped()).(core.Parser[T]), schema, true
+	case *core.OptionalSchema[T]:
+		inner, object, isObject := stripObject(schema.Inner)
+		return core.NewOptionalSchema(inner), object, isObject
+	case *core.DefaultSchema[T]:
+		inner, object, isObject := stripObject(schema.Inner)
+		return core.NewDefaultSchema(inner, schema.DefaultValue), object, isObject
+	case *core.LazySchema[T]:
+		return stripObject(schema.Resolved())
+	}
+	return parser, nil, false
+}
+
+// intersect merges the results of both sides. Objects are merged key by key,
+// whereas any other values must be deeply equal.
+func (s *IntersectionSchema[T]) intersect(value interface{}, leftResult *core.Result[T], rightResult *core.Result[T], state *core.State) *core.Result[T] {
+	finalResult := s.Schema.NewSuccessResult()
+	finalResult.AddIssues(leftResult.Issues...)
+	finalResult.AddIssues(rightResult.Issues...)
+	if !finalResult.Ok {
+		return finalResult
+	}
+
+	merged, mergeIssues := mergeValues(leftResult.Value, rightResult.Value)
+	if len(mergeIssues) > 0 {
+		finalResult.AddIssues(mergeIssues...)
+		return finalResult
+	}
+
+	finalResult.AddIssues(s.unknownKeys(value, merged)...)
+	if !finalResult.Ok && state.AbortEarly() {
+		return finalResult
+	}
+
+	baseResult := s.Schema.ParseGenericState(merged.(T), state)
+	if finalResult.Ok {
+		return baseResult
+	}
+	finalResult.AddIssues(baseResult.Issues...)
+	return finalResult
+}
+
+// unknownKeys applies the stricter unknown keys policy of two objects to the
+// keys of the input that neither of them declares, passing them through into
+// the merged object when both allow it.
+func (s *IntersectionSchema[T]) unknownKeys(value interface{}, merged interface{}) []core.Issue {
+	left, right, areObjects := s.objects()
+	if !areObjects {
+		return nil
+	}
+
+	policy := left.UnknownKeys
+	if right.UnknownKeys < policy {
+		policy = right.UnknownKeys
+	}
+
+	input, _ := toObject(value)
+	var issues []core.Issue
+	for _, key := range sortedKeys(input) {
+		_, declaredLeft := left.Shape[key]
+		_, declaredRight := right.Shape[key]
+		if declaredLeft || declaredRight {
+			continue
+		}
+
+		switch policy {
+		case RejectUnknownKeys:
+			issues = append(issues, unrecognizedKey(key, input[key]))
+		case PassthroughUnknownKeys:
+			merged.(map[string]interface{})[key] = input[key]
+		}
+	}
+	return issues
+}
+
+func mergeValues(left interface{}, right interface{}) (interface{}, []core.Issue) {
+	leftMap, leftIsMap := left.(map[string]interface{})
+	rightMap, rightIsMap := right.(map[string]interface{})
+	if !leftIsMap || !rightIsMap {
+		if !reflect.DeepEqual(left, right) {
+			return nil, []core.Issue{{
+				Code:     core.InvalidIntersection,
+				Received: core.TypeName(left),
+				Message:  "Intersection results could not be merged",
+			}}
+		}
+		return left, nil
+	}
+
+	merged := make(map[string]interface{}, len(leftMap)+len(rightMap))
+	var issues []core.Issue
+	for key, leftValue := range leftMap {
+		merged[key] = leftValue
+	}
+	for _, key := range sortedKeys(rightMap) {
+		leftValue, shared := leftMap[key]
+		if !shared {
+			merged[key] = rightMap[key]
+			continue
+		}
+
+		mergedValue, mergeIssues := mergeValues(leftValue, rightMap[key])
+		if len(mergeIssues) > 0 {
+			issues = append(issues, core.PrependPath(mergeIssues, key)...)
+			continue
+		}
+		merged[key] = mergedValue
+	}
+	return merged, issues
+}
+
+func (s *IntersectionSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *IntersectionSchema[T]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindIntersection)
+	description.Options = []*core.Description{core.Describe(s.Left), core.Describe(s.Right)}
+	return description
+}
+
+func (s *IntersectionSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *IntersectionSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, func() T { return value })
+}
+
+func (s *IntersectionSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, defaultValue)
+}
+
+func (s *IntersectionSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](s, fallback)
+}
+
+func (s *IntersectionSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *IntersectionSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *IntersectionSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *IntersectionSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *IntersectionSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *IntersectionSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *IntersectionSchema[T]) Messages(messageFunc core.MessageFunc) *IntersectionSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *IntersectionSchema[T]) FailFast() *IntersectionSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *IntersectionSchema[T]) Description(text string) *IntersectionSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *IntersectionSchema[T]) Example(value T) *IntersectionSchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *IntersectionSchema[T]) Then() *IntersectionSchema[T] {
+	s.Schema.Then()
+	return s
+}
@@ -0,0 +1,107 @@
+package composites_test
+
+import (
+	"testing"
+	"time"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntersectionSchema_Objects(t *testing.T) {
+	audited := composites.NewObjectSchema("Audited", composites.Shape{
+		"created_at": primitives.NewDateSchema("created_at"),
+	})
+	user := composites.NewObjectSchema("User", composites.Shape{
+		"name": primitives.NewStringSchema("name").Min(1),
+	})
+	schema := composites.NewIntersectionSchema[map[string]interface{}]("AuditedUser", audited, user)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := schema.Parse(map[string]interface{}{"created_at": createdAt, "name": "Abyan"})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]interface{}{"created_at": createdAt, "name": "Abyan"}, result.Value)
+
+	result = schema.ParseTyped(map[string]interface{}{"created_at": createdAt, "name": "Abyan", "extra": 1})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Unrecognized key 'extra'"}, result.Errors)
+
+	user.Passthrough()
+	result = schema.Parse(map[string]interface{}{"created_at": createdAt, "name": "Abyan", "extra": 1})
+	assert.False(t, result.Ok)
+
+	audited.Strip()
+	result = schema.Parse(map[string]interface{}{"created_at": createdAt, "name": "Abyan", "extra": 1})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]interface{}{"created_at": createdAt, "name": "Abyan"}, result.Value)
+
+	audited.Passthrough()
+	result = schema.Parse(map[string]interface{}{"created_at": createdAt, "name": "Abyan", "extra": 1})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]interface{}{"created_at": createdAt, "name": "Abyan", "extra": 1}, result.Value)
+
+	result = schema.Parse(map[string]interface{}{"name": ""})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{
+		"created_at: Missing required key",
+		"name: Must be at least 1 characters long",
+	}, result.Errors)
+}
+
+func TestIntersectionSchema_WrappedObjects(t *testing.T) {
+	audited := composites.NewObjectSchema("Audited", composites.Shape{
+		"created_at": primitives.NewDateSchema("created_at"),
+	})
+	user := composites.NewObjectSchema("User", composites.Shape{
+		"name": primitives.NewStringSchema("name").Min(1),
+	})
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	schema := composites.NewIntersectionSchema[map[string]interface{}]("AuditedUser",
+		core.NewOptionalSchema[map[string]interface{}](audited),
+		core.NewLazySchema(func() core.Parser[map[string]interface{}] { return user }),
+	)
+	result := schema.Parse(map[string]interface{}{"created_at": createdAt, "name": "Abyan"})
+	assert.True(t, result.Ok, result.Errors)
+	assert.Equal(t, map[string]interface{}{"created_at": createdAt, "name": "Abyan"}, result.Value)
+
+	result = schema.Parse(map[string]interface{}{"created_at": createdAt, "name": "Abyan", "extra": 1})
+	assert.Equal(t, []string{"Unrecognized key 'extra'"}, result.Errors)
+
+	schema = composites.NewIntersectionSchema[map[string]interface{}]("AuditedUser",
+		core.NewDefaultSchema[map[string]interface{}](audited, func() map[string]interface{} {
+			return map[string]interface{}{"created_at": createdAt, "name": "Abyan"}
+		}),
+		user,
+	)
+	result = schema.Parse(map[string]interface{}{"created_at": createdAt, "name": "Abyan"})
+	assert.True(t, result.Ok, result.Errors)
+}
+
+func TestIntersectionSchema_Values(t *testing.T) {
+	schema := composites.NewIntersectionSchema[int]("Score",
+		primitives.NewNumberSchema[int]("Score").Gte(0),
+		primitives.NewNumberSchema[int]("Score").Lte(100),
+	)
+
+	assert.True(t, schema.Parse(50).Ok)
+	assert.Equal(t, []string{"Must be smaller than or equal to 100"}, schema.Parse(150).Errors)
+	assert.Equal(t, []string{"Must be greater than or equal to 0"}, schema.ParseTyped(-1).Errors)
+}
+
+func TestIntersectionSchema_Unmergeable(t *testing.T) {
+	trimmed := primitives.NewStringSchema("Name")
+	schema := composites.NewIntersectionSchema[map[string]interface{}]("Name",
+		composites.NewObjectSchema("Left", composites.Shape{"name": trimmed}),
+		composites.NewObjectSchema("Right", composites.Shape{"name": core.NewTransformSchema[string, string](trimmed, func(value string) (string, error) {
+			return value + "!", nil
+		})}),
+	)
+
+	result := schema.Parse(map[string]interface{}{"name": "Abyan"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.InvalidIntersection, result.Issues[0].Code)
+	assert.Equal(t, "name", result.Issues[0].Path.String())
+}
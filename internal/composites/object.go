@@ -0,0 +1,395 @@
+package composites
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+type Shape map[string]core.AnyParser
+
+// UnknownKeys policies are ordered from the strictest to the most lenient.
+type UnknownKeys int
+
+const (
+	RejectUnknownKeys UnknownKeys = iota
+	StripUnknownKeys
+	PassthroughUnknownKeys
+)
+
+type ObjectSchema struct {
+	Schema      *core.Schema[map[string]interface{}]
+	Shape       Shape
+	UnknownKeys UnknownKeys
+	// Workers is the number of goroutines parsing fields, where zero and one
+	// both mean the fields are parsed sequentially.
+	Workers int
+}
+
+func NewObjectSchema(path string, shape Shape) *ObjectSchema {
+	return &ObjectSchema{
+		Schema: &core.Schema[map[string]interface{}]{
+			Path:  path,
+			Rules: []core.Rule[map[string]interface{}]{},
+		},
+		Shape:       shape,
+		UnknownKeys: RejectUnknownKeys,
+	}
+}
+
+func (s *ObjectSchema) Parse(value interface{}) *core.Result[map[string]interface{}] {
+	return s.ParseState(value, nil)
+}
+
+func (s *ObjectSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[map[string]interface{}] {
+	return core.ParseContext[map[string]interface{}](ctx, s, value)
+}
+
+func (s *ObjectSchema) ParseState(value interface{}, state *core.State) *core.Result[map[string]interface{}] {
+	result, _ := s.parseFields(value, state)
+	return result
+}
+
+func (s *ObjectSchema) ParseTyped(value map[string]interface{}) *core.Result[map[string]interface{}] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *ObjectSchema) ParseTypedState(value map[string]interface{}, state *core.State) *core.Result[map[string]interface{}] {
+	result, _ := s.parseMap(value, state)
+	return result
+}
+
+func (s *ObjectSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *ObjectSchema) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindObject)
+	description.Fields = make(map[string]*core.Description, len(s.Shape))
+	for _, key := range sortedKeys(s.Shape) {
+		fieldDescription := core.Describe(s.Shape[key])
+		description.Fields[key] = fieldDescription
+		if !fieldDescription.Optional && !fieldDescription.HasDefault {
+			description.Required = append(description.Required, key)
+		}
+	}
+	description.UnknownKeys = s.UnknownKeys != RejectUnknownKeys
+	return description
+}
+
+func (s *ObjectSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *ObjectSchema) Default(value map[string]interface{}) *core.DefaultSchema[map[string]interface{}] {
+	return core.NewDefaultSchema[map[string]interface{}](s, func() map[string]interface{} { return value })
+}
+
+func (s *ObjectSchema) DefaultFunc(defaultValue func() map[string]interface{}) *core.DefaultSchema[map[string]interface{}] {
+	return core.NewDefaultSchema[map[string]interface{}](s, defaultValue)
+}
+
+func (s *ObjectSchema) Catch(fallback map[string]interface{}) *core.CatchSchema[map[string]interface{}] {
+	return core.NewCatchSchema[map[string]interface{}](s, fallback)
+}
+
+func (s *ObjectSchema) Refine(check func(map[string]interface{}) bool, message string, opts ...core.RefineOptions) *ObjectSchema {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *ObjectSchema) SuperRefine(refinement func(value map[string]interface{}, ctx *core.RefinementCtx)) *ObjectSchema {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *ObjectSchema) RefineContext(check func(ctx context.Context, value map[string]interface{}) error) *ObjectSchema {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *ObjectSchema) Messages(messageFunc core.MessageFunc) *ObjectSchema {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+// Parallel parses the fields on a pool of workers, or on one worker per CPU
+// when workers is below one. Issues are still reported in key order.
+func (s *ObjectSchema) Parallel(workers int) *ObjectSchema {
+	s.Workers = parallelism(workers)
+	return s
+}
+
+func (s *ObjectSchema) FailFast() *ObjectSchema {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *ObjectSchema) Description(text string) *ObjectSchema {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *ObjectSchema) Example(value map[string]interface{}) *ObjectSchema {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *ObjectSchema) Then() *ObjectSchema {
+	s.Schema.Then()
+	return s
+}
+
+// ParseFields behaves like Parse, but also returns the individual result of
+// every key declared in the shape.
+func (s *ObjectSchema) ParseFields(value interface{}) (*core.Result[map[string]interface{}], map[string]*core.Result[interface{}]) {
+	return s.parseFields(value, nil)
+}
+
+func (s *ObjectSchema) parseFields(value interface{}, state *core.State) (*core.Result[map[string]interface{}], map[string]*core.Result[interface{}]) {
+	input, isObject := toObject(value)
+	if !isObject {
+		return s.Schema.NewTypeErrorResult(value, "object", "Must be an object"), map[string]*core.Result[interface{}]{}
+	}
+
+	return s.parseMap(input, state)
+}
+
+func toObject(value interface{}) (map[string]interface{}, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+
+	input := make(map[string]interface{}, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		input[iter.Key().String()] = iter.Value().Interface()
+	}
+	return input, true
+}
+
+func (s *ObjectSchema) parseMap(input map[string]interface{}, state *core.State) (*core.Result[map[string]interface{}], map[string]*core.Result[interface{}]) {
+	finalResult := s.Schema.NewSuccessResult()
+	fieldResults := make(map[string]*core.Result[interface{}], len(s.Shape))
+	parsedObject := make(map[string]interface{}, len(input))
+
+	keys := sortedKeys(s.Shape)
+	absent := make([]bool, len(keys))
+	results := parseAll(len(keys), s.Workers, state, func(i int) *core.Result[interface{}] {
+		fieldValue, exists := input[keys[i]]
+		if !exists {
+			fieldResult, present := core.ParseAbsent(s.Shape[keys[i]], state)
+			fieldResult.Path = keys[i]
+			absent[i] = !present
+			return fieldResult
+		}
+		return core.ParseAnyState(s.Shape[keys[i]], fieldValue, state)
+	})
+
+	for i, fieldResult := range results {
+		key := keys[i]
+		fieldResults[key] = fieldResult
+		if !fieldResult.Ok {
+			finalResult.AddIssues(core.PrependPath(fieldResult.Issues, key)...)
+			if state.AbortEarly() {
+				return finalResult, fieldResults
+			}
+			continue
+		}
+
+		if !absent[i] {
+			parsedObject[key] = fieldResult.Value
+		}
+	}
+
+	for _, key := range sortedKeys(input) {
+		if _, declared := s.Shape[key]; declared {
+			continue
+		}
+
+		switch s.UnknownKeys {
+		case RejectUnknownKeys:
+			finalResult.AddIssues(unrecognizedKey(key, input[key]))
+			if state.AbortEarly() {
+				return finalResult, fieldResults
+			}
+		case PassthroughUnknownKeys:
+			parsedObject[key] = input[key]
+		}
+	}
+
+	baseResult := s.Schema.ParseGenericState(parsedObject, state)
+	finalResult.AddIssues(baseResult.Issues...)
+
+	finalResult.Value = parsedObject
+	return finalResult, fieldResults
+}
+
+func unrecognizedKey(key string, value interface{}) core.Issue {
+	return core.Issue{
+		Code:     core.UnrecognizedKey,
+		Params:   core.Params{"key": key},
+		Received: core.TypeName(value),
+		Message:  fmt.Sprintf("Unrecognized key '%s'", key),
+	}
+}
+
+func (s *ObjectSchema) Strict() *ObjectSchema {
+	s.UnknownKeys = RejectUnknownKeys
+	return s
+}
+
+func (s *ObjectSchema) Strip() *ObjectSchema {
+	s.UnknownKeys = StripUnknownKeys
+	return s
+}
+
+func (s *ObjectSchema) Passthrough() *ObjectSchema {
+	s.UnknownKeys = PassthroughUnknownKeys
+	return s
+}
+
+// Extend returns a new object schema with the keys of the shape added, or
+// replaced when already declared. Like every derived schema, it keeps the
+// unknown keys policy, messages and workers, but not the object-level rules.
+func (s *ObjectSchema) Extend(shape Shape) *ObjectSchema {
+	extended := s.derive(len(s.Shape) + len(shape))
+	for key, fieldSchema := range s.Shape {
+		extended.Shape[key] = fieldSchema
+	}
+	for key, fieldSchema := range shape {
+		extended.Shape[key] = fieldSchema
+	}
+	return extended
+}
+
+// Merge returns a new object schema with the keys of both schemas. Keys of
+// other win over keys of s, and so does its unknown keys policy.
+func (s *ObjectSchema) Merge(other *ObjectSchema) *ObjectSchema {
+	merged := s.Extend(other.Shape)
+	merged.UnknownKeys = other.UnknownKeys
+	return merged
+}
+
+// Pick returns a new object schema with only the given keys. Like Omit,
+// Partial and Required, it panics when a key is not declared in the shape.
+func (s *ObjectSchema) Pick(keys ...string) *ObjectSchema {
+	picked := s.derive(len(keys))
+	for _, key := range keys {
+		picked.Shape[key] = s.field(key)
+	}
+	return picked
+}
+
+// Omit returns a new object schema without the given keys.
+func (s *ObjectSchema) Omit(keys ...string) *ObjectSchema {
+	omitted := s.Extend(nil)
+	for _, key := range keys {
+		s.field(key)
+		delete(omitted.Shape, key)
+	}
+	return omitted
+}
+
+// Partial returns a new object schema whose given keys, or all keys when none
+// are given, are optional. It panics when a given key is not declared.
+func (s *ObjectSchema) Partial(keys ...string) *ObjectSchema {
+	partial := s.Extend(nil)
+	for _, key := range s.keysOrAll(keys) {
+		if _, isOptional := partial.Shape[key].(optionalParser); !isOptional {
+			partial.Shape[key] = optionalField{inner: partial.Shape[key]}
+		}
+	}
+	return partial
+}
+
+// Required returns a new object schema whose given keys, or all keys when none
+// are given, are no longer optional. It panics when a given key is not
+// declared.
+func (s *ObjectSchema) Required(keys ...string) *ObjectSchema {
+	required := s.Extend(nil)
+	for _, key := range s.keysOrAll(keys) {
+		if optional, isOptional := required.Shape[key].(optionalParser); isOptional {
+			required.Shape[key] = optional.Unwrap()
+		}
+	}
+	return required
+}
+
+func (s *ObjectSchema) derive(size int) *ObjectSchema {
+	derived := NewObjectSchema(s.Schema.Path, make(Shape, size))
+	derived.Schema.MessageFunc = s.Schema.MessageFunc
+	derived.UnknownKeys = s.UnknownKeys
+	derived.Workers = s.Workers
+	return derived
+}
+
+// stripped returns a copy of the schema that drops unknown keys.
+func (s *ObjectSchema) stripped() *ObjectSchema {
+	stripped := *s
+	stripped.UnknownKeys = StripUnknownKeys
+	return &stripped
+}
+
+func (s *ObjectSchema) field(key string) core.AnyParser {
+	fieldSchema, declared := s.Shape[key]
+	if !declared {
+		panic(fmt.Sprintf("v: unknown key %q in object %s", key, s.Schema.Path))
+	}
+	return fieldSchema
+}
+
+func (s *ObjectSchema) keysOrAll(keys []string) []string {
+	if len(keys) == 0 {
+		return sortedKeys(s.Shape)
+	}
+	for _, key := range keys {
+		s.field(key)
+	}
+	return keys
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// optionalField makes a field of a shape optional without knowing its type.
+type optionalField struct {
+	inner core.AnyParser
+}
+
+func (f optionalField) ParseAny(value interface{}) *core.Result[interface{}] {
+	return f.inner.ParseAny(value)
+}
+
+func (f optionalField) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return core.ParseAnyState(f.inner, value, state)
+}
+
+func (f optionalField) ParseAbsent(state *core.State) (*core.Result[interface{}], bool) {
+	return &core.Result[interface{}]{Ok: true}, false
+}
+
+func (f optionalField) Unwrap() core.AnyParser {
+	return f.inner
+}
+
+func (f optionalField) Describe() *core.Description {
+	description := core.Describe(f.inner)
+	description.Optional = true
+	return description
+}
+
+type optionalParser interface {
+	core.AbsentParser
+	Unwrap() core.AnyParser
+}
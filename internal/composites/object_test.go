@@ -0,0 +1,367 @@
+package composites_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func newApplicantSchema() *composites.ObjectSchema {
+	return composites.NewObjectSchema("Applicant", composites.Shape{
+		"name":       primitives.NewStringSchema("Name").Min(1),
+		"email":      primitives.NewStringSchema("Email").Email(),
+		"university": literals.NewEnumSchema("University", []string{"USYD", "UMELB", "UNSW"}),
+		"wam":        primitives.NewNumberSchema[float64]("WAM").Gte(0).Lte(100),
+	})
+}
+
+func TestNewObjectSchema(t *testing.T) {
+	schema := newApplicantSchema()
+
+	assert.NotNil(t, schema)
+	assert.Equal(t, "Applicant", schema.Schema.Path)
+	assert.Len(t, schema.Shape, 4)
+	assert.Equal(t, composites.RejectUnknownKeys, schema.UnknownKeys)
+}
+
+func TestObjectSchema_Parse(t *testing.T) {
+	schema := newApplicantSchema()
+
+	t.Run("Valid object", func(t *testing.T) {
+		result := schema.Parse(map[string]interface{}{
+			"name":       "Abyan",
+			"email":      "abyan@example.com",
+			"university": "UNSW",
+			"wam":        85.0,
+		})
+		assert.True(t, result.Ok)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, "Abyan", result.Value["name"])
+		assert.Equal(t, 85.0, result.Value["wam"])
+	})
+
+	t.Run("Invalid fields", func(t *testing.T) {
+		result := schema.Parse(map[string]interface{}{
+			"name":       "",
+			"email":      "not-an-email",
+			"university": "MIT",
+			"wam":        85.0,
+		})
+		assert.False(t, result.Ok)
+		assert.Contains(t, result.Errors, "email: Must be a valid email address")
+		assert.Contains(t, result.Errors, "university: Value is not in the allowed enum set.")
+		assert.NotContains(t, result.Value, "email")
+		assert.Equal(t, 85.0, result.Value["wam"])
+	})
+
+	t.Run("Missing and unrecognized keys", func(t *testing.T) {
+		result := schema.Parse(map[string]interface{}{
+			"name":       "Abyan",
+			"email":      "abyan@example.com",
+			"university": "UNSW",
+			"twitter":    "@abyan",
+		})
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{"wam: Missing required key", "Unrecognized key 'twitter'"}, result.Errors)
+	})
+
+	t.Run("Typed map input", func(t *testing.T) {
+		schema := composites.NewObjectSchema("Labels", composites.Shape{
+			"env": primitives.NewStringSchema("Env"),
+		})
+		result := schema.Parse(map[string]string{"env": "prod"})
+		assert.True(t, result.Ok)
+		assert.Equal(t, map[string]interface{}{"env": "prod"}, result.Value)
+	})
+
+	t.Run("Not an object", func(t *testing.T) {
+		result := schema.Parse([]string{"Abyan"})
+		assert.False(t, result.Ok)
+		assert.Contains(t, result.Errors, "Must be an object")
+	})
+}
+
+func TestObjectSchema_ParseFields(t *testing.T) {
+	schema := newApplicantSchema()
+
+	result, fields := schema.ParseFields(map[string]interface{}{
+		"name":       "Abyan",
+		"email":      "nope",
+		"university": "USYD",
+	})
+	assert.False(t, result.Ok)
+	assert.Len(t, fields, 4)
+	assert.True(t, fields["name"].Ok)
+	assert.Equal(t, "Abyan", fields["name"].Value)
+	assert.False(t, fields["email"].Ok)
+	assert.Equal(t, []string{"Must be a valid email address"}, fields["email"].Errors)
+	assert.False(t, fields["wam"].Ok)
+	assert.Equal(t, []string{"Missing required key"}, fields["wam"].Errors)
+}
+
+func TestObjectSchema_UnknownKeys(t *testing.T) {
+	input := map[string]interface{}{"name": "Abyan", "extra": true}
+
+	t.Run("Strip", func(t *testing.T) {
+		schema := composites.NewObjectSchema("Person", composites.Shape{
+			"name": primitives.NewStringSchema("Name"),
+		}).Strip()
+		result := schema.Parse(input)
+		assert.True(t, result.Ok)
+		assert.Equal(t, map[string]interface{}{"name": "Abyan"}, result.Value)
+	})
+
+	t.Run("Passthrough", func(t *testing.T) {
+		schema := composites.NewObjectSchema("Person", composites.Shape{
+			"name": primitives.NewStringSchema("Name"),
+		}).Passthrough()
+		result := schema.Parse(input)
+		assert.True(t, result.Ok)
+		assert.Equal(t, input, result.Value)
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		schema := composites.NewObjectSchema("Person", composites.Shape{
+			"name": primitives.NewStringSchema("Name"),
+		}).Passthrough().Strict()
+		result := schema.Parse(input)
+		assert.False(t, result.Ok)
+		assert.Contains(t, result.Errors, "Unrecognized key 'extra'")
+	})
+}
+
+func TestObjectSchema_Nested(t *testing.T) {
+	schema := composites.NewObjectSchema("Applicant", composites.Shape{
+		"address": composites.NewObjectSchema("Address", composites.Shape{
+			"city": primitives.NewStringSchema("City").Min(1),
+		}),
+		"courseworks": composites.NewArraySchema("Courseworks", primitives.NewStringSchema("Coursework")),
+	})
+
+	result := schema.ParseTyped(map[string]interface{}{
+		"address":     map[string]interface{}{"city": ""},
+		"courseworks": []string{"COMP1511"},
+	})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"address: city: Must be at least 1 characters long"}, result.Errors)
+}
+
+func TestObjectSchema_Issues(t *testing.T) {
+	schema := composites.NewObjectSchema("Applicant", composites.Shape{
+		"name":        primitives.NewStringSchema("Name").Min(1),
+		"courseworks": composites.NewArraySchema("Courseworks", primitives.NewStringSchema("Coursework").Min(4)),
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"courseworks": []interface{}{"COMP1511", "ECO"},
+		"twitter":     "@abyan",
+	})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 3)
+
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+	assert.Equal(t, core.Path{"courseworks", 1}, result.Issues[0].Path)
+	assert.Equal(t, "courseworks: Element at index 1: Must be at least 4 characters long", result.Errors[0])
+
+	assert.Equal(t, core.Required, result.Issues[1].Code)
+	assert.Equal(t, core.Path{"name"}, result.Issues[1].Path)
+
+	assert.Equal(t, core.UnrecognizedKey, result.Issues[2].Code)
+	assert.Equal(t, "twitter", result.Issues[2].Params["key"])
+	assert.Empty(t, result.Issues[2].Path)
+}
+
+func TestObjectSchema_NestedPaths(t *testing.T) {
+	applicant := composites.NewObjectSchema("Applicant", composites.Shape{
+		"email": primitives.NewStringSchema("Email").Email(),
+	})
+	schema := composites.NewObjectSchema("Cohort", composites.Shape{
+		"applicants": composites.NewArraySchema("Applicants", applicant),
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"applicants": []interface{}{
+			map[string]interface{}{"email": "abyan@example.com"},
+			map[string]interface{}{"email": "nope"},
+		},
+	})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.Path{"applicants", 1, "email"}, result.Issues[0].Path)
+	assert.Equal(t, "applicants[1].email", result.Issues[0].Path.String())
+	assert.Equal(t, "/applicants/1/email", result.Issues[0].Path.Pointer())
+	assert.Equal(t, map[string][]string{"/applicants/1/email": {"Must be a valid email address"}}, result.ErrorsByPointer())
+}
+
+func TestObjectSchema_AbsentAndNull(t *testing.T) {
+	schema := composites.NewObjectSchema("Profile", composites.Shape{
+		"name":     primitives.NewStringSchema("Name"),
+		"nickname": core.NewOptionalSchema[string](primitives.NewStringSchema("Nickname")),
+		"bio":      core.NewNullableSchema[string](primitives.NewStringSchema("Bio")),
+		"role":     primitives.NewStringSchema("Role").Default("member"),
+		"page":     primitives.NewNumberSchema[float64]("Page").Catch(1),
+	})
+
+	t.Run("Absent keys", func(t *testing.T) {
+		result := schema.Parse(map[string]interface{}{"name": "Abyan"})
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{"bio: Missing required key"}, result.Errors)
+		assert.Equal(t, map[string]interface{}{"name": "Abyan", "role": "member", "page": 1.0}, result.Value)
+		assert.NotContains(t, result.Value, "nickname")
+	})
+
+	t.Run("Explicit nulls", func(t *testing.T) {
+		result := schema.Parse(map[string]interface{}{
+			"name":     "Abyan",
+			"nickname": nil,
+			"bio":      nil,
+			"role":     nil,
+			"page":     "two",
+		})
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{"nickname: Must be a string."}, result.Errors)
+		assert.Equal(t, (*string)(nil), result.Value["bio"])
+		assert.Equal(t, "member", result.Value["role"])
+		assert.Equal(t, 1.0, result.Value["page"])
+	})
+}
+
+func newUserSchema() *composites.ObjectSchema {
+	return composites.NewObjectSchema("User", composites.Shape{
+		"id":       primitives.NewStringSchema("id").UUID(),
+		"name":     primitives.NewStringSchema("name").Min(1),
+		"email":    primitives.NewStringSchema("email").Email(),
+		"password": primitives.NewStringSchema("password").Min(8),
+		"nickname": core.NewOptionalSchema[string](primitives.NewStringSchema("nickname")),
+	})
+}
+
+func TestObjectSchema_Derived(t *testing.T) {
+	user := newUserSchema()
+	createUser := user.Omit("id")
+	updateUser := createUser.Partial()
+	publicUser := user.Pick("id", "name", "nickname").Strip()
+
+	assert.Equal(t, []string{"email", "name", "nickname", "password"}, sortedShapeKeys(createUser))
+	assert.Len(t, user.Shape, 5)
+
+	result := createUser.Parse(map[string]interface{}{"name": "Abyan", "email": "abyan@example.com", "password": "hunter22"})
+	assert.True(t, result.Ok)
+
+	result = updateUser.Parse(map[string]interface{}{"email": "abyan@example.com"})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]interface{}{"email": "abyan@example.com"}, result.Value)
+
+	result = updateUser.Parse(map[string]interface{}{"password": "short"})
+	assert.Equal(t, []string{"password: Must be at least 8 characters long"}, result.Errors)
+
+	description := updateUser.Describe()
+	assert.Empty(t, description.Required)
+	assert.Equal(t, core.KindString, description.Fields["email"].Kind)
+
+	result = publicUser.Parse(map[string]interface{}{"id": "123e4567-e89b-12d3-a456-426614174000", "name": "Abyan", "password": "hunter22"})
+	assert.True(t, result.Ok)
+	assert.NotContains(t, result.Value, "password")
+}
+
+func TestObjectSchema_Required(t *testing.T) {
+	updateUser := newUserSchema().Partial("name", "email")
+
+	result := updateUser.Parse(map[string]interface{}{"id": "123e4567-e89b-12d3-a456-426614174000", "password": "hunter22"})
+	assert.True(t, result.Ok)
+
+	result = updateUser.Required().Parse(map[string]interface{}{})
+	assert.Equal(t, []string{
+		"email: Missing required key",
+		"id: Missing required key",
+		"name: Missing required key",
+		"nickname: Missing required key",
+		"password: Missing required key",
+	}, result.Errors)
+
+	result = newUserSchema().Required("nickname").Parse(map[string]interface{}{"nickname": 1})
+	assert.Contains(t, result.Errors, "nickname: Must be a string.")
+}
+
+func TestObjectSchema_ExtendAndMerge(t *testing.T) {
+	audited := composites.NewObjectSchema("Audited", composites.Shape{
+		"created_at": primitives.NewDateSchema("created_at"),
+		"name":       primitives.NewNumberSchema[int]("name"),
+	}).Strip()
+	user := composites.NewObjectSchema("User", composites.Shape{
+		"name": primitives.NewStringSchema("name"),
+	}).Refine(func(value map[string]interface{}) bool { return false }, "Never valid")
+
+	merged := user.Merge(audited)
+	assert.Equal(t, []string{"created_at", "name"}, sortedShapeKeys(merged))
+	assert.Equal(t, composites.StripUnknownKeys, merged.UnknownKeys)
+	assert.Equal(t, []string{"name: Must be a number."}, merged.Parse(map[string]interface{}{"created_at": time.Now(), "name": "Abyan"}).Errors)
+
+	extended := user.Extend(composites.Shape{"age": primitives.NewNumberSchema[int]("age")})
+	assert.Equal(t, []string{"age", "name"}, sortedShapeKeys(extended))
+	assert.True(t, extended.Parse(map[string]interface{}{"name": "Abyan", "age": 20}).Ok)
+}
+
+func TestObjectSchema_DeriveUnknownKey(t *testing.T) {
+	assert.PanicsWithValue(t, `v: unknown key "mail" in object User`, func() {
+		newUserSchema().Pick("mail")
+	})
+	assert.Panics(t, func() { newUserSchema().Omit("mail") })
+	assert.Panics(t, func() { newUserSchema().Partial("mail") })
+	assert.Panics(t, func() { newUserSchema().Required("mail") })
+}
+
+func sortedShapeKeys(s *composites.ObjectSchema) []string {
+	keys := make([]string, 0, len(s.Shape))
+	for key := range s.Shape {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestObjectSchema_AbortEarly(t *testing.T) {
+	schema := composites.NewObjectSchema("user", composites.Shape{
+		"name":  primitives.NewStringSchema("name").Min(1),
+		"email": primitives.NewStringSchema("email").Email(),
+		"tags":  composites.NewArraySchema[string]("tags", primitives.NewStringSchema("tag").Min(2)),
+	})
+	input := map[string]interface{}{"name": "", "email": "nope", "tags": []interface{}{"a", "b"}, "extra": true}
+
+	result := core.ParseWith[map[string]interface{}](schema, input, core.ParseOptions{AbortEarly: true})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, core.Path{"email"}, result.Issues[0].Path)
+
+	result = schema.Parse(input)
+	assert.Len(t, result.Issues, 5)
+}
+
+func TestObjectSchema_Parallel(t *testing.T) {
+	newSchema := func() *composites.ObjectSchema {
+		return composites.NewObjectSchema("user", composites.Shape{
+			"name":     primitives.NewStringSchema("name").Min(1),
+			"email":    primitives.NewStringSchema("email").Email(),
+			"nickname": core.NewOptionalSchema[string](primitives.NewStringSchema("nickname")),
+			"role":     core.NewDefaultSchema[string](primitives.NewStringSchema("role"), func() string { return "member" }),
+			"age":      primitives.NewNumberSchema[int]("age").Gte(18),
+		})
+	}
+
+	input := map[string]interface{}{"name": "", "email": "nope", "age": 12}
+	sequential := newSchema().Parse(input)
+	parallel := newSchema().Parallel(4).Parse(input)
+	assert.False(t, parallel.Ok)
+	assert.Equal(t, sequential.Issues, parallel.Issues)
+
+	result := newSchema().Parallel(4).Parse(map[string]interface{}{"name": "Abyan", "email": "abyan@example.com", "age": 21})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]interface{}{"name": "Abyan", "email": "abyan@example.com", "age": 21, "role": "member"}, result.Value)
+
+	assert.Equal(t, 4, newSchema().Parallel(4).Pick("name").Workers)
+}
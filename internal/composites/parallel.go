@@ -0,0 +1,66 @@
+package composites
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+// parallelism resolves the worker count given to Parallel, where anything
+// below one means one worker per CPU.
+func parallelism(workers int) int {
+	if workers < 1 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
+
+// parseAll calls parse for every index below n and returns the results by
+// index, so callers can report issues in a deterministic order. With more
+// than one worker, the indexes are handed out in increasing order to a pool
+// of goroutines. A parse that aborts early stops handing out indexes after
+// the first failure; every index before the first failure still gets parsed,
+// so the issue reported does not depend on scheduling.
+func parseAll[T any](n int, workers int, state *core.State, parse func(i int) *core.Result[T]) []*core.Result[T] {
+	results := make([]*core.Result[T], n)
+
+	if workers <= 1 || n <= 1 {
+		for i := range results {
+			results[i] = parse(i)
+			if !results[i].Ok && state.AbortEarly() {
+				break
+			}
+		}
+		return results
+	}
+
+	if workers > n {
+		workers = n
+	}
+
+	var next atomic.Int64
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !failed.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+
+				results[i] = parse(i)
+				if !results[i].Ok && state.AbortEarly() {
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
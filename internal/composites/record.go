@@ -0,0 +1,270 @@
+package composites
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/literals"
+)
+
+type RecordSchema[K comparable, V any] struct {
+	Schema       *core.Schema[map[K]V]
+	Keys         core.Parser[K]
+	Values       core.Parser[V]
+	RequiredKeys []K
+
+	// sizeRules are added by MinSize and MaxSize. They check the number of
+	// entries of the input, including the entries that failed.
+	sizeRules []func(size int, value map[K]V) *core.Result[map[K]V]
+}
+
+type recordEntry[K any, V any] struct {
+	key   K
+	value V
+}
+
+func NewRecordSchema[K comparable, V any](path string, keys core.Parser[K], values core.Parser[V]) *RecordSchema[K, V] {
+	return &RecordSchema[K, V]{
+		Schema: &core.Schema[map[K]V]{
+			Path:  path,
+			Rules: []core.Rule[map[K]V]{},
+		},
+		Keys:   keys,
+		Values: values,
+	}
+}
+
+func (s *RecordSchema[K, V]) Parse(value interface{}) *core.Result[map[K]V] {
+	return s.ParseState(value, nil)
+}
+
+func (s *RecordSchema[K, V]) ParseContext(ctx context.Context, value interface{}) *core.Result[map[K]V] {
+	return core.ParseContext[map[K]V](ctx, s, value)
+}
+
+func (s *RecordSchema[K, V]) ParseState(value interface{}, state *core.State) *core.Result[map[K]V] {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return s.Schema.NewTypeErrorResult(value, "record", "Must be a map")
+	}
+
+	entries := make([]recordEntry[interface{}, interface{}], 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		entries = append(entries, recordEntry[interface{}, interface{}]{iter.Key().Interface(), iter.Value().Interface()})
+	}
+
+	return parseRecord(s, entries, state,
+		func(key interface{}) *core.Result[K] { return core.ParseState(s.Keys, key, state) },
+		func(value interface{}) *core.Result[V] { return core.ParseState(s.Values, value, state) },
+	)
+}
+
+func (s *RecordSchema[K, V]) ParseTyped(value map[K]V) *core.Result[map[K]V] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *RecordSchema[K, V]) ParseTypedState(value map[K]V, state *core.State) *core.Result[map[K]V] {
+	entries := make([]recordEntry[K, V], 0, len(value))
+	for key, entryValue := range value {
+		entries = append(entries, recordEntry[K, V]{key, entryValue})
+	}
+
+	return parseRecord(s, entries, state,
+		func(key K) *core.Result[K] { return core.ParseTypedState(s.Keys, key, state) },
+		func(value V) *core.Result[V] { return core.ParseTypedState(s.Values, value, state) },
+	)
+}
+
+func parseRecord[K comparable, V any, EK any, EV any](s *RecordSchema[K, V], entries []recordEntry[EK, EV], state *core.State, parseKey func(EK) *core.Result[K], parseValue func(EV) *core.Result[V]) *core.Result[map[K]V] {
+	sort.Slice(entries, func(i, j int) bool {
+		return fmt.Sprint(entries[i].key) < fmt.Sprint(entries[j].key)
+	})
+
+	parsedRecord := make(map[K]V, len(entries))
+	finalResult := s.Schema.NewSuccessResult()
+	failedEntries := 0
+
+	for _, entry := range entries {
+		segment := fmt.Sprint(entry.key)
+
+		keyResult := parseKey(entry.key)
+		if !keyResult.Ok {
+			failedEntries++
+			finalResult.AddIssues(core.PrependPath(keyResult.Issues, segment)...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+			continue
+		}
+
+		valueResult := parseValue(entry.value)
+		if !valueResult.Ok {
+			failedEntries++
+			finalResult.AddIssues(core.PrependPath(valueResult.Issues, segment)...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+			continue
+		}
+
+		parsedRecord[keyResult.Value] = valueResult.Value
+	}
+
+	for _, key := range s.RequiredKeys {
+		if _, exists := parsedRecord[key]; exists || hasEntry(entries, key) {
+			continue
+		}
+
+		absentResult, present := core.ParseAbsent(s.Values, state)
+		if !absentResult.Ok {
+			finalResult.AddIssues(core.PrependPath(absentResult.Issues, fmt.Sprint(key))...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+		} else if present {
+			parsedRecord[key] = as[V](absentResult.Value)
+		}
+	}
+
+	for _, sizeRule := range s.sizeRules {
+		sizeResult := sizeRule(len(parsedRecord)+failedEntries, parsedRecord)
+		if !sizeResult.Ok {
+			finalResult.AddIssues(sizeResult.Issues...)
+			if s.Schema.FailFast || state.AbortEarly() {
+				return finalResult
+			}
+		}
+	}
+
+	baseResult := s.Schema.ParseGenericState(parsedRecord, state)
+	finalResult.AddIssues(baseResult.Issues...)
+
+	finalResult.Value = parsedRecord
+	return finalResult
+}
+
+func hasEntry[K comparable, EK any, EV any](entries []recordEntry[EK, EV], key K) bool {
+	for _, entry := range entries {
+		if entryKey, isKey := interface{}(entry.key).(K); isKey && entryKey == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Exhaustive requires every value of the enum key schema to be present.
+func (s *RecordSchema[K, V]) Exhaustive() *RecordSchema[K, V] {
+	enum, isEnum := s.Keys.(*literals.EnumSchema[K])
+	if !isEnum {
+		panic(fmt.Sprintf("v: Exhaustive requires an Enum key schema in record %s", s.Schema.Path))
+	}
+
+	s.RequiredKeys = enum.Values
+	return s
+}
+
+func (s *RecordSchema[K, V]) MinSize(minSize int, message ...string) *RecordSchema[K, V] {
+	params := core.Params{"type": "record", "min": minSize, "inclusive": true}
+	s.Schema.Checks = append(s.Schema.Checks, core.Check{Code: core.TooSmall, Params: params})
+	s.sizeRules = append(s.sizeRules, func(size int, value map[K]V) *core.Result[map[K]V] {
+		if size < minSize {
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: fmt.Sprintf("Must have at least %d entries", minSize),
+			}, message...)
+		}
+		return s.Schema.NewSuccessResult()
+	})
+	return s
+}
+
+func (s *RecordSchema[K, V]) MaxSize(maxSize int, message ...string) *RecordSchema[K, V] {
+	params := core.Params{"type": "record", "max": maxSize, "inclusive": true}
+	s.Schema.Checks = append(s.Schema.Checks, core.Check{Code: core.TooBig, Params: params})
+	s.sizeRules = append(s.sizeRules, func(size int, value map[K]V) *core.Result[map[K]V] {
+		if size > maxSize {
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: fmt.Sprintf("Must have at most %d entries", maxSize),
+			}, message...)
+		}
+		return s.Schema.NewSuccessResult()
+	})
+	return s
+}
+
+func (s *RecordSchema[K, V]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *RecordSchema[K, V]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindRecord)
+	description.Key = core.Describe(s.Keys)
+	description.Element = core.Describe(s.Values)
+	for _, key := range s.RequiredKeys {
+		description.Required = append(description.Required, fmt.Sprint(key))
+	}
+	return description
+}
+
+func (s *RecordSchema[K, V]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *RecordSchema[K, V]) Default(value map[K]V) *core.DefaultSchema[map[K]V] {
+	return core.NewDefaultSchema[map[K]V](s, func() map[K]V { return value })
+}
+
+func (s *RecordSchema[K, V]) DefaultFunc(defaultValue func() map[K]V) *core.DefaultSchema[map[K]V] {
+	return core.NewDefaultSchema[map[K]V](s, defaultValue)
+}
+
+func (s *RecordSchema[K, V]) Catch(fallback map[K]V) *core.CatchSchema[map[K]V] {
+	return core.NewCatchSchema[map[K]V](s, fallback)
+}
+
+func (s *RecordSchema[K, V]) Refine(check func(map[K]V) bool, message string, opts ...core.RefineOptions) *RecordSchema[K, V] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *RecordSchema[K, V]) SuperRefine(refinement func(value map[K]V, ctx *core.RefinementCtx)) *RecordSchema[K, V] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *RecordSchema[K, V]) RefineContext(check func(ctx context.Context, value map[K]V) error) *RecordSchema[K, V] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *RecordSchema[K, V]) Messages(messageFunc core.MessageFunc) *RecordSchema[K, V] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *RecordSchema[K, V]) FailFast() *RecordSchema[K, V] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *RecordSchema[K, V]) Description(text string) *RecordSchema[K, V] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *RecordSchema[K, V]) Example(value map[K]V) *RecordSchema[K, V] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *RecordSchema[K, V]) Then() *RecordSchema[K, V] {
+	s.Schema.Then()
+	return s
+}
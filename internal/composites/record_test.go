@@ -0,0 +1,89 @@
+package composites_test
+
+import (
+	"regexp"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordSchema(t *testing.T) {
+	flags := composites.NewRecordSchema[string, bool]("Flags",
+		primitives.NewStringSchema("Flag").Regex(regexp.MustCompile(`^[a-z0-9-]+$`)),
+		primitives.NewBooleanSchema("Enabled"),
+	)
+
+	result := flags.Parse(map[string]interface{}{"dark-mode": true, "beta": false})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]bool{"dark-mode": true, "beta": false}, result.Value)
+
+	result = flags.Parse(map[string]interface{}{"Dark Mode": true, "beta": "yes"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{
+		"Dark Mode: Must match the required pattern",
+		"beta: Must be a boolean",
+	}, result.Errors)
+	assert.Equal(t, "/beta", result.Issues[1].Path.Pointer())
+	assert.Equal(t, map[string]bool{}, result.Value)
+
+	result = flags.ParseTyped(map[string]bool{"ok": true})
+	assert.True(t, result.Ok)
+
+	result = flags.Parse([]interface{}{})
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+}
+
+func TestRecordSchema_Size(t *testing.T) {
+	schema := composites.NewRecordSchema[string, float64]("Scores",
+		primitives.NewStringSchema("Name"),
+		primitives.NewNumberSchema[float64]("Score"),
+	).MinSize(2).MaxSize(3)
+
+	assert.Equal(t, []string{"Must have at least 2 entries"}, schema.Parse(map[string]float64{"a": 1}).Errors)
+	assert.Equal(t, []string{"Must have at most 3 entries"}, schema.Parse(map[string]float64{"a": 1, "b": 2, "c": 3, "d": 4}).Errors)
+
+	// Entries that failed still count towards the size of the input.
+	assert.Equal(t, []string{"b: Must be a number."}, schema.Parse(map[string]interface{}{"a": 1.0, "b": "2"}).Errors)
+	assert.Equal(t, []string{
+		"d: Must be a number.",
+		"Must have at most 3 entries",
+	}, schema.Parse(map[string]interface{}{"a": 1.0, "b": 2.0, "c": 3.0, "d": "4"}).Errors)
+
+	nonempty := composites.NewRecordSchema[string, float64]("Scores",
+		primitives.NewStringSchema("Name"),
+		primitives.NewNumberSchema[float64]("Score"),
+	).MinSize(1)
+	assert.Equal(t, []string{"Must have at least 1 entry"}, nonempty.Parse(map[string]float64{}).Localize("en").Errors)
+}
+
+func TestRecordSchema_Exhaustive(t *testing.T) {
+	currencies := literals.NewEnumSchema("Currency", []string{"AUD", "IDR", "USD"})
+	prices := composites.NewRecordSchema[string, float64]("Prices", currencies, primitives.NewNumberSchema[float64]("Price").Positive())
+
+	result := prices.Parse(map[string]interface{}{"AUD": 10.0, "EUR": 9.0})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.NotInEnum, result.Issues[0].Code)
+	assert.Equal(t, "EUR", result.Issues[0].Path.String())
+
+	prices.Exhaustive()
+	result = prices.Parse(map[string]interface{}{"AUD": 10.0, "USD": -1.0})
+	assert.Equal(t, []string{
+		"USD: Must be a positive number",
+		"IDR: Missing required key",
+	}, result.Errors)
+
+	withDefaults := composites.NewRecordSchema[string, float64]("Prices", currencies,
+		primitives.NewNumberSchema[float64]("Price").Default(0),
+	).Exhaustive()
+	result = withDefaults.Parse(map[string]interface{}{"AUD": 10.0})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]float64{"AUD": 10, "IDR": 0, "USD": 0}, result.Value)
+
+	assert.Panics(t, func() {
+		composites.NewRecordSchema[string, bool]("Flags", primitives.NewStringSchema("Flag"), primitives.NewBooleanSchema("Enabled")).Exhaustive()
+	})
+}
@@ -0,0 +1,244 @@
+package composites
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+type SetSchema[T comparable] struct {
+	Schema *core.Schema[map[T]struct{}]
+	Inner  core.Parser[T]
+}
+
+func NewSetSchema[T comparable](path string, inner core.Parser[T]) *SetSchema[T] {
+	return &SetSchema[T]{
+		Schema: &core.Schema[map[T]struct{}]{
+			Path:  path,
+			Rules: []core.Rule[map[T]struct{}]{},
+		},
+		Inner: inner,
+	}
+}
+
+// Parse accepts slices, whose duplicates are reported at their index, as well
+// as maps, whose keys are the elements of the set.
+func (s *SetSchema[T]) Parse(value interface{}) *core.Result[map[T]struct{}] {
+	return s.ParseState(value, nil)
+}
+
+func (s *SetSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[map[T]struct{}] {
+	return core.ParseContext[map[T]struct{}](ctx, s, value)
+}
+
+func (s *SetSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[map[T]struct{}] {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		parsedSet := make(map[T]struct{}, v.Len())
+		finalResult := s.Schema.NewSuccessResult()
+		firstIndexes := make(map[T]int, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			elementResult := core.ParseState(s.Inner, v.Index(i).Interface(), state)
+			if !elementResult.Ok {
+				finalResult.AddIssues(core.PrependPath(elementResult.Issues, i)...)
+				if state.AbortEarly() {
+					return finalResult
+				}
+				continue
+			}
+
+			if first, exists := firstIndexes[elementResult.Value]; exists {
+				finalResult.AddIssues(s.Schema.NewIssueResult(elementResult.Value, core.Issue{
+					Code:    core.NotUnique,
+					Path:    core.Path{i},
+					Params:  core.Params{"duplicate_of": first},
+					Message: fmt.Sprintf("Duplicate of element at index %d", first),
+				}).Issues...)
+				if state.AbortEarly() {
+					return finalResult
+				}
+				continue
+			}
+
+			firstIndexes[elementResult.Value] = i
+			parsedSet[elementResult.Value] = struct{}{}
+		}
+
+		return s.finish(finalResult, parsedSet, state)
+	case reflect.Map:
+		keys := make([]interface{}, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			keys = append(keys, key.Interface())
+		}
+		return parseSetKeys(s, keys, state, func(key interface{}) *core.Result[T] {
+			return core.ParseState(s.Inner, key, state)
+		})
+	}
+
+	return s.Schema.NewTypeErrorResult(value, "set", "Must be an array or a set")
+}
+
+func (s *SetSchema[T]) ParseTyped(value map[T]struct{}) *core.Result[map[T]struct{}] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *SetSchema[T]) ParseTypedState(value map[T]struct{}, state *core.State) *core.Result[map[T]struct{}] {
+	keys := make([]T, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	return parseSetKeys(s, keys, state, func(key T) *core.Result[T] {
+		return core.ParseTypedState(s.Inner, key, state)
+	})
+}
+
+// parseSetKeys parses the keys of a map in a stable order, so that issues are
+// reported in the same order every time.
+func parseSetKeys[T comparable, K any](s *SetSchema[T], keys []K, state *core.State, parseKey func(K) *core.Result[T]) *core.Result[map[T]struct{}] {
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+
+	parsedSet := make(map[T]struct{}, len(keys))
+	finalResult := s.Schema.NewSuccessResult()
+	for _, key := range keys {
+		elementResult := parseKey(key)
+		if !elementResult.Ok {
+			finalResult.AddIssues(core.PrependPath(elementResult.Issues, fmt.Sprint(key))...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+			continue
+		}
+
+		parsedSet[elementResult.Value] = struct{}{}
+	}
+
+	return s.finish(finalResult, parsedSet, state)
+}
+
+func (s *SetSchema[T]) finish(finalResult *core.Result[map[T]struct{}], parsedSet map[T]struct{}, state *core.State) *core.Result[map[T]struct{}] {
+	baseResult := s.Schema.ParseGenericState(parsedSet, state)
+	finalResult.AddIssues(baseResult.Issues...)
+
+	finalResult.Value = parsedSet
+	return finalResult
+}
+
+func (s *SetSchema[T]) MinSize(minSize int, message ...string) *SetSchema[T] {
+	params := core.Params{"type": "set", "min": minSize, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value map[T]struct{}) *core.Result[map[T]struct{}] {
+		if len(value) < minSize {
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: fmt.Sprintf("Set must have at least %d elements", minSize),
+			}, message...)
+		}
+		return s.Schema.NewSuccessResult()
+	})
+	return s
+}
+
+func (s *SetSchema[T]) MaxSize(maxSize int, message ...string) *SetSchema[T] {
+	params := core.Params{"type": "set", "max": maxSize, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value map[T]struct{}) *core.Result[map[T]struct{}] {
+		if len(value) > maxSize {
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: fmt.Sprintf("Set must have at most %d elements", maxSize),
+			}, message...)
+		}
+		return s.Schema.NewSuccessResult()
+	})
+	return s
+}
+
+func (s *SetSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *SetSchema[T]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindSet)
+	description.Element = core.Describe(s.Inner)
+
+	// Examples are described as arrays, which is how sets are encoded.
+	description.Examples = nil
+	for _, example := range s.Schema.Examples {
+		elements := make([]interface{}, 0, len(example))
+		for element := range example {
+			elements = append(elements, element)
+		}
+		sort.Slice(elements, func(i, j int) bool {
+			return fmt.Sprint(elements[i]) < fmt.Sprint(elements[j])
+		})
+		description.Examples = append(description.Examples, elements)
+	}
+	return description
+}
+
+func (s *SetSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *SetSchema[T]) Default(value map[T]struct{}) *core.DefaultSchema[map[T]struct{}] {
+	return core.NewDefaultSchema[map[T]struct{}](s, func() map[T]struct{} { return value })
+}
+
+func (s *SetSchema[T]) DefaultFunc(defaultValue func() map[T]struct{}) *core.DefaultSchema[map[T]struct{}] {
+	return core.NewDefaultSchema[map[T]struct{}](s, defaultValue)
+}
+
+func (s *SetSchema[T]) Catch(fallback map[T]struct{}) *core.CatchSchema[map[T]struct{}] {
+	return core.NewCatchSchema[map[T]struct{}](s, fallback)
+}
+
+func (s *SetSchema[T]) Refine(check func(map[T]struct{}) bool, message string, opts ...core.RefineOptions) *SetSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *SetSchema[T]) SuperRefine(refinement func(value map[T]struct{}, ctx *core.RefinementCtx)) *SetSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *SetSchema[T]) RefineContext(check func(ctx context.Context, value map[T]struct{}) error) *SetSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *SetSchema[T]) Messages(messageFunc core.MessageFunc) *SetSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *SetSchema[T]) FailFast() *SetSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *SetSchema[T]) Description(text string) *SetSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *SetSchema[T]) Example(values ...T) *SetSchema[T] {
+	example := make(map[T]struct{}, len(values))
+	for _, value := range values {
+		example[value] = struct{}{}
+	}
+	s.Schema.Examples = append(s.Schema.Examples, example)
+	return s
+}
+
+func (s *SetSchema[T]) Then() *SetSchema[T] {
+	s.Schema.Then()
+	return s
+}
@@ -0,0 +1,66 @@
+package composites_test
+
+import (
+	"strconv"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSchema_Slices(t *testing.T) {
+	schema := composites.NewSetSchema[string]("Tags", primitives.NewStringSchema("Tag").Min(2))
+
+	result := schema.Parse([]interface{}{"go", "rust"})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[string]struct{}{"go": {}, "rust": {}}, result.Value)
+
+	result = schema.Parse([]string{"go", "c", "rust", "go"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{
+		"Element at index 1: Must be at least 2 characters long",
+		"Element at index 3: Duplicate of element at index 0",
+	}, result.Errors)
+	assert.Equal(t, core.Path{3}, result.Issues[1].Path)
+	assert.Equal(t, core.NotUnique, result.Issues[1].Code)
+}
+
+func TestSetSchema_Maps(t *testing.T) {
+	schema := composites.NewSetSchema[string]("Tags", primitives.NewStringSchema("Tag").Min(2)).MinSize(2)
+
+	result := schema.Parse(map[string]struct{}{"go": {}, "rust": {}})
+	assert.True(t, result.Ok)
+
+	result = schema.ParseTyped(map[string]struct{}{"c": {}})
+	assert.Equal(t, []string{
+		"c: Must be at least 2 characters long",
+		"Set must have at least 2 elements",
+	}, result.Errors)
+
+	result = schema.Parse("go")
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+}
+
+func TestSetSchema_MaxSize(t *testing.T) {
+	schema := composites.NewSetSchema[int]("Numbers", primitives.NewNumberSchema[int]("Number")).MaxSize(1)
+
+	assert.True(t, schema.Parse([]int{1}).Ok)
+	assert.Equal(t, []string{"Set must have at most 1 element"}, schema.Parse([]int{1, 2}).Localize("en").Errors)
+}
+
+func TestSetSchema_ParseTypedTransform(t *testing.T) {
+	schema := composites.NewSetSchema[int]("IDs", core.NewPipeSchema[int, int](
+		core.NewTransformSchema[string, int](primitives.NewStringSchema("ID"), strconv.Atoi),
+		primitives.NewNumberSchema[int]("ID").Positive(),
+	))
+
+	result := schema.Parse([]interface{}{"1", "2"})
+	assert.True(t, result.Ok)
+	assert.Equal(t, map[int]struct{}{1: {}, 2: {}}, result.Value)
+
+	// Parsed elements are validated as they are rather than transformed again.
+	assert.Equal(t, result, schema.ParseTyped(result.Value))
+	assert.Equal(t, []string{"-1: Must be a positive number"}, schema.ParseTyped(map[int]struct{}{-1: {}, 1: {}}).Errors)
+}
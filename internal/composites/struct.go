@@ -0,0 +1,576 @@
+package composites
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+)
+
+type StructSchema[T any] struct {
+	Schema *core.Schema[T]
+	Fields []StructField
+}
+
+type StructField struct {
+	Name        string
+	Index       int
+	Tag         string
+	parse       fieldParser
+	description *core.Description
+}
+
+type fieldParser func(value reflect.Value, state *core.State) *core.Result[interface{}]
+
+var structFieldCache sync.Map
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func NewStructSchema[T any](path string) *StructSchema[T] {
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("v: Struct requires a struct type, got %s", structType))
+	}
+
+	return &StructSchema[T]{
+		Schema: &core.Schema[T]{
+			Path:  path,
+			Rules: []core.Rule[T]{},
+		},
+		Fields: structFieldsOf(structType),
+	}
+}
+
+func (s *StructSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *StructSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, s, value)
+}
+
+func (s *StructSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
+	switch typedValue := value.(type) {
+	case T:
+		return s.parseTyped(typedValue, state)
+	case *T:
+		if typedValue != nil {
+			return s.parseTyped(*typedValue, state)
+		}
+	}
+
+	return s.Schema.NewTypeErrorResult(value, "struct", "Must be a struct")
+}
+
+func (s *StructSchema[T]) ParseTyped(value T) *core.Result[T] {
+	return s.parseTyped(value, nil)
+}
+
+func (s *StructSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	return s.parseTyped(value, state)
+}
+
+func (s *StructSchema[T]) parseTyped(value T, state *core.State) *core.Result[T] {
+	finalResult := s.Schema.NewSuccessResult()
+
+	structResult := parseStructFields(s.Schema.Path, s.Fields, reflect.ValueOf(value), state)
+	finalResult.AddIssues(structResult.Issues...)
+	if !finalResult.Ok && state.AbortEarly() {
+		return finalResult
+	}
+
+	baseResult := s.Schema.ParseGenericState(value, state)
+	finalResult.AddIssues(baseResult.Issues...)
+
+	finalResult.Value = value
+	return finalResult
+}
+
+func (s *StructSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *StructSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *StructSchema[T]) Describe() *core.Description {
+	description := describeStruct(s.Schema.Path, s.Fields)
+	description.Checks = s.Schema.Describe(core.KindObject).Checks
+	description.Ref = reflect.TypeOf((*T)(nil)).Elem()
+	return s.Schema.Document(description)
+}
+
+func (s *StructSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, func() T { return value })
+}
+
+func (s *StructSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, defaultValue)
+}
+
+func (s *StructSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](s, fallback)
+}
+
+func (s *StructSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *StructSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *StructSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *StructSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *StructSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *StructSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *StructSchema[T]) Messages(messageFunc core.MessageFunc) *StructSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *StructSchema[T]) FailFast() *StructSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *StructSchema[T]) Description(text string) *StructSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *StructSchema[T]) Example(value T) *StructSchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *StructSchema[T]) Then() *StructSchema[T] {
+	s.Schema.Then()
+	return s
+}
+
+// structFieldsOf compiles the `v` tags of a struct type once and caches the
+// result, so that subsequent schemas of the same type skip reflection over tags.
+func structFieldsOf(structType reflect.Type) []StructField {
+	return compileStruct(structType, map[reflect.Type]bool{})
+}
+
+// compileStruct compiles the fields of a struct type, along with the structs
+// nested in them so that bad tags panic when the schema is created. Structs
+// already being compiled are skipped, as a type may refer back to itself.
+func compileStruct(structType reflect.Type, compiling map[reflect.Type]bool) []StructField {
+	if cached, ok := structFieldCache.Load(structType); ok {
+		return cached.([]StructField)
+	}
+	compiling[structType] = true
+
+	var fields []StructField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("v")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+
+		name := fieldName(field)
+		parse, description := compileField(name, field.Type, splitDirectives(tag), compiling)
+
+		fields = append(fields, StructField{
+			Name:        name,
+			Index:       i,
+			Tag:         tag,
+			parse:       parse,
+			description: description,
+		})
+	}
+
+	cached, _ := structFieldCache.LoadOrStore(structType, fields)
+	return cached.([]StructField)
+}
+
+func parseStructFields(path string, fields []StructField, value reflect.Value, state *core.State) *core.Result[interface{}] {
+	finalResult := &core.Result[interface{}]{Ok: true, Path: path, Value: value.Interface()}
+
+	for _, field := range fields {
+		fieldResult := field.parse(value.Field(field.Index), state)
+		if !fieldResult.Ok {
+			finalResult.AddIssues(core.PrependPath(fieldResult.Issues, field.Name)...)
+			if state.AbortEarly() {
+				break
+			}
+		}
+	}
+
+	return finalResult
+}
+
+// describeStruct describes the fields of a struct as an object. Fields are
+// required unless they are optional pointers, as the zero value of any other
+// field is validated like any other value.
+func describeStruct(path string, fields []StructField) *core.Description {
+	description := &core.Description{Kind: core.KindObject, Path: path, Fields: make(map[string]*core.Description, len(fields))}
+	for _, field := range fields {
+		description.Fields[field.Name] = field.description
+		if !field.description.Optional {
+			description.Required = append(description.Required, field.Name)
+		}
+	}
+	return description
+}
+
+func fieldName(field reflect.StructField) string {
+	jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+	if jsonName != "" && jsonName != "-" {
+		return jsonName
+	}
+	return field.Name
+}
+
+// splitDirectives splits a tag such as "min=1,max=128,email" into its
+// directives. A regex directive consumes the rest of the tag, so that the
+// pattern itself may contain commas.
+func splitDirectives(tag string) []string {
+	var directives []string
+	for tag != "" {
+		if strings.HasPrefix(tag, "regex=") {
+			return append(directives, tag)
+		}
+
+		directive, rest, _ := strings.Cut(tag, ",")
+		if directive = strings.TrimSpace(directive); directive != "" {
+			directives = append(directives, directive)
+		}
+		tag = rest
+	}
+	return directives
+}
+
+func compileField(path string, fieldType reflect.Type, directives []string, compiling map[reflect.Type]bool) (fieldParser, *core.Description) {
+	var elementDirectives []string
+	for i, directive := range directives {
+		if directive == "dive" {
+			elementDirectives = directives[i+1:]
+			directives = directives[:i]
+			break
+		}
+	}
+
+	required := false
+	remaining := directives[:0:0]
+	for _, directive := range directives {
+		if directive == "required" {
+			required = true
+			continue
+		}
+		remaining = append(remaining, directive)
+	}
+
+	parse, description := compileKind(path, fieldType, remaining, elementDirectives, compiling)
+	description.Optional = !required && fieldType.Kind() == reflect.Ptr
+	schema := &core.Schema[interface{}]{Path: path}
+
+	return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+		if value.IsZero() {
+			if required {
+				return schema.NewIssueResult(value.Interface(), core.Issue{Code: core.Required, Message: "Is required"})
+			}
+			if value.Kind() == reflect.Ptr {
+				return &core.Result[interface{}]{Ok: true, Path: path}
+			}
+		}
+		return parse(value, state)
+	}, description
+}
+
+func compileKind(path string, fieldType reflect.Type, directives []string, elementDirectives []string, compiling map[reflect.Type]bool) (fieldParser, *core.Description) {
+	if fieldType == timeType {
+		return compileDate(path, directives)
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Ptr:
+		parse, description := compileKind(path, fieldType.Elem(), directives, elementDirectives, compiling)
+		description.Nullable = true
+		return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+			return parse(value.Elem(), state)
+		}, description
+	case reflect.String:
+		return compileString(path, directives)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		schema, enum := compileNumber(path, directives, func(arg string) (int64, error) {
+			return strconv.ParseInt(arg, 10, 64)
+		})
+		return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+			return withEnum(schema.Schema.ParseGenericState(value.Int(), state).ToAny(), enum, value.Int())
+		}, describeWithEnum(schema.Describe(), enum)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema, enum := compileNumber(path, directives, func(arg string) (uint64, error) {
+			return strconv.ParseUint(arg, 10, 64)
+		})
+		return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+			return withEnum(schema.Schema.ParseGenericState(value.Uint(), state).ToAny(), enum, value.Uint())
+		}, describeWithEnum(schema.Describe(), enum)
+	case reflect.Float32, reflect.Float64:
+		schema, enum := compileNumber(path, directives, func(arg string) (float64, error) {
+			return strconv.ParseFloat(arg, 64)
+		})
+		return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+			return withEnum(schema.Schema.ParseGenericState(value.Float(), state).ToAny(), enum, value.Float())
+		}, describeWithEnum(schema.Describe(), enum)
+	case reflect.Bool:
+		rejectDirectives(path, directives)
+		schema := primitives.NewBooleanSchema(path)
+		return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+			return schema.Schema.ParseGenericState(value.Bool(), state).ToAny()
+		}, schema.Describe()
+	case reflect.Struct:
+		rejectDirectives(path, directives)
+		structType := fieldType
+		if !compiling[structType] {
+			compileStruct(structType, compiling)
+		}
+		// Nested structs are described lazily, as their fields may refer back
+		// to the struct itself.
+		return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+			return parseStructFields(path, structFieldsOf(structType), value, state)
+		}, &core.Description{Kind: core.KindLazy, Path: path, Ref: structType, Resolve: func() *core.Description {
+			return describeStruct(path, structFieldsOf(structType))
+		}}
+	case reflect.Slice, reflect.Array:
+		return compileSlice(path, fieldType, directives, elementDirectives, compiling)
+	}
+
+	rejectDirectives(path, directives)
+	return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+		return &core.Result[interface{}]{Ok: true, Path: path, Value: value.Interface()}
+	}, &core.Description{Kind: core.KindAny, Path: path}
+}
+
+func compileString(path string, directives []string) (fieldParser, *core.Description) {
+	schema := primitives.NewStringSchema(path)
+	var enum *literals.EnumSchema[string]
+
+	for _, directive := range directives {
+		name, arg, _ := strings.Cut(directive, "=")
+		switch name {
+		case "min":
+			schema.Min(mustAtoi(path, directive, arg))
+		case "max":
+			schema.Max(mustAtoi(path, directive, arg))
+		case "len":
+			schema.Length(mustAtoi(path, directive, arg))
+		case "email":
+			schema.Email()
+		case "url":
+			schema.URL()
+		case "regex":
+			schema.Regex(regexp.MustCompile(arg))
+		case "includes":
+			schema.Includes(arg)
+		case "startswith":
+			schema.StartsWith(arg)
+		case "endswith":
+			schema.EndsWith(arg)
+		case "date":
+			schema.Date()
+		case "time":
+			schema.Time()
+		case "ip":
+			schema.IP()
+		case "cidr":
+			schema.CIDR()
+		case "uuid":
+			schema.UUID()
+		case "nanoid":
+			schema.NanoID()
+		case "cuid":
+			schema.CUID()
+		case "cuid2":
+			schema.CUID2()
+		case "ulid":
+			schema.ULID()
+		case "oneof":
+			enum = literals.NewEnumSchema(path, strings.Split(arg, "|"))
+		default:
+			panic(unknownDirective(path, directive))
+		}
+	}
+
+	return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+		return withEnum(schema.Schema.ParseGenericState(value.String(), state).ToAny(), enum, value.String())
+	}, describeWithEnum(schema.Describe(), enum)
+}
+
+func compileNumber[N primitives.Number](path string, directives []string, parseArg func(string) (N, error)) (*primitives.NumberSchema[N], *literals.EnumSchema[N]) {
+	schema := primitives.NewNumberSchema[N](path)
+	var enum *literals.EnumSchema[N]
+
+	bound := func(directive, arg string) N {
+		parsed, err := parseArg(arg)
+		if err != nil {
+			panic(fmt.Sprintf("v: invalid argument in directive %q on field %s", directive, path))
+		}
+		return parsed
+	}
+
+	for _, directive := range directives {
+		name, arg, _ := strings.Cut(directive, "=")
+		switch name {
+		case "gt":
+			schema.Gt(bound(directive, arg))
+		case "gte", "min":
+			schema.Gte(bound(directive, arg))
+		case "lt":
+			schema.Lt(bound(directive, arg))
+		case "lte", "max":
+			schema.Lte(bound(directive, arg))
+		case "positive":
+			schema.Positive()
+		case "nonnegative":
+			schema.NonNegative()
+		case "negative":
+			schema.Negative()
+		case "nonpositive":
+			schema.NonPositive()
+		case "multipleof":
+			schema.MultipleOf(bound(directive, arg))
+		case "finite":
+			schema.Finite()
+		case "oneof":
+			var allowedValues []N
+			for _, allowed := range strings.Split(arg, "|") {
+				allowedValues = append(allowedValues, bound(directive, allowed))
+			}
+			enum = literals.NewEnumSchema(path, allowedValues)
+		default:
+			panic(unknownDirective(path, directive))
+		}
+	}
+
+	return schema, enum
+}
+
+func compileDate(path string, directives []string) (fieldParser, *core.Description) {
+	schema := primitives.NewDateSchema(path)
+
+	for _, directive := range directives {
+		name, arg, _ := strings.Cut(directive, "=")
+		switch name {
+		case "min", "max":
+			bound, err := time.Parse(time.RFC3339, arg)
+			if err != nil {
+				panic(fmt.Sprintf("v: invalid argument in directive %q on field %s", directive, path))
+			}
+			if name == "min" {
+				schema.Min(bound)
+			} else {
+				schema.Max(bound)
+			}
+		default:
+			panic(unknownDirective(path, directive))
+		}
+	}
+
+	return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+		return schema.Schema.ParseGenericState(value.Interface().(time.Time), state).ToAny()
+	}, schema.Describe()
+}
+
+func compileSlice(path string, sliceType reflect.Type, directives []string, elementDirectives []string, compiling map[reflect.Type]bool) (fieldParser, *core.Description) {
+	schema := NewArraySchema[interface{}](path, primitives.NewAnySchema(path))
+
+	for _, directive := range directives {
+		name, arg, _ := strings.Cut(directive, "=")
+		switch name {
+		case "min":
+			schema.Min(mustAtoi(path, directive, arg))
+		case "max":
+			schema.Max(mustAtoi(path, directive, arg))
+		case "len":
+			schema.Length(mustAtoi(path, directive, arg))
+		case "nonempty":
+			schema.Nonempty()
+		case "unique":
+			schema.Unique()
+		default:
+			panic(unknownDirective(path, directive))
+		}
+	}
+
+	parseElement, elementDescription := compileField(path, sliceType.Elem(), elementDirectives, compiling)
+	description := schema.Schema.Describe(core.KindArray)
+	description.Element = elementDescription
+
+	return func(value reflect.Value, state *core.State) *core.Result[interface{}] {
+		finalResult := &core.Result[interface{}]{Ok: true, Path: path, Value: value.Interface()}
+		elements := make([]interface{}, value.Len())
+
+		for i := 0; i < value.Len(); i++ {
+			elements[i] = value.Index(i).Interface()
+			elementResult := parseElement(value.Index(i), state)
+			if !elementResult.Ok {
+				finalResult.AddIssues(core.PrependPath(elementResult.Issues, i)...)
+				if state.AbortEarly() {
+					return finalResult
+				}
+			}
+		}
+
+		arrayResult := schema.ParseTypedState(elements, state)
+		finalResult.AddIssues(arrayResult.Issues...)
+
+		return finalResult
+	}, description
+}
+
+// describeWithEnum describes a field restricted by a oneof directive as an
+// enum that keeps the checks of the underlying schema.
+func describeWithEnum[E comparable](description *core.Description, enum *literals.EnumSchema[E]) *core.Description {
+	if enum == nil {
+		return description
+	}
+
+	enumDescription := enum.Describe()
+	enumDescription.Checks = description.Checks
+	return enumDescription
+}
+
+func withEnum[E comparable](result *core.Result[interface{}], enum *literals.EnumSchema[E], value E) *core.Result[interface{}] {
+	if enum == nil {
+		return result
+	}
+
+	enumResult := enum.Parse(value)
+	result.AddIssues(enumResult.Issues...)
+	return result
+}
+
+func rejectDirectives(path string, directives []string) {
+	if len(directives) > 0 {
+		panic(unknownDirective(path, directives[0]))
+	}
+}
+
+func mustAtoi(path, directive, arg string) int {
+	parsed, err := strconv.Atoi(arg)
+	if err != nil {
+		panic(fmt.Sprintf("v: invalid argument in directive %q on field %s", directive, path))
+	}
+	return parsed
+}
+
+func unknownDirective(path, directive string) string {
+	return fmt.Sprintf("v: unknown directive %q on field %s", directive, path)
+}
@@ -0,0 +1,232 @@
+package composites_test
+
+import (
+	"testing"
+	"time"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/stretchr/testify/assert"
+)
+
+type taggedAddress struct {
+	City     string `json:"city" v:"min=1"`
+	Postcode string `json:"postcode" v:"len=4"`
+}
+
+type taggedApplicant struct {
+	Name         string         `json:"name" v:"min=1,max=128"`
+	Email        string         `json:"email" v:"email"`
+	University   string         `json:"university" v:"oneof=USYD|UMELB|UNSW"`
+	WAM          int            `json:"wam" v:"gte=0,lte=100"`
+	Score        float64        `v:"finite,multipleof=0.5"`
+	HasGraduated bool           `json:"has_graduated"`
+	Courseworks  []string       `json:"courseworks" v:"nonempty,dive,startswith=COMP"`
+	Born         time.Time      `json:"born" v:"max=2010-01-01T00:00:00Z"`
+	Address      taggedAddress  `json:"address"`
+	Mentor       *taggedAddress `json:"mentor"`
+	Nickname     *string        `json:"nickname" v:"required,min=2"`
+	Pattern      string         `v:"regex=^[a-z]{1,3}$"`
+	internal     string
+	Ignored      string `v:"-"`
+}
+
+func validTaggedApplicant() taggedApplicant {
+	nickname := "Aby"
+	return taggedApplicant{
+		Name:        "Abyan",
+		Email:       "abyan@example.com",
+		University:  "UNSW",
+		WAM:         85,
+		Score:       7.5,
+		Courseworks: []string{"COMP1511", "COMP2521"},
+		Born:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Address:     taggedAddress{City: "Sydney", Postcode: "2000"},
+		Nickname:    &nickname,
+		Pattern:     "abc",
+	}
+}
+
+func TestNewStructSchema(t *testing.T) {
+	schema := composites.NewStructSchema[taggedApplicant]("Applicant")
+
+	assert.NotNil(t, schema)
+	assert.Equal(t, "Applicant", schema.Schema.Path)
+	assert.Len(t, schema.Fields, 12)
+	assert.Equal(t, "name", schema.Fields[0].Name)
+	assert.Equal(t, "min=1,max=128", schema.Fields[0].Tag)
+	assert.Equal(t, "Score", schema.Fields[4].Name)
+
+	assert.Panics(t, func() { composites.NewStructSchema[int]("Number") })
+	assert.Panics(t, func() {
+		composites.NewStructSchema[struct {
+			Name string `v:"bogus"`
+		}]("Bogus")
+	})
+	assert.Panics(t, func() {
+		composites.NewStructSchema[struct {
+			Name string `v:"min=abc"`
+		}]("Bogus")
+	})
+
+	// Nested structs are compiled along with the struct, even when they refer
+	// back to themselves.
+	type node struct {
+		Children []node
+		Label    string `v:"bogus"`
+	}
+	assert.Panics(t, func() {
+		composites.NewStructSchema[struct {
+			Root *node
+		}]("Tree")
+	})
+}
+
+func TestStructSchema_Parse(t *testing.T) {
+	schema := composites.NewStructSchema[taggedApplicant]("Applicant")
+
+	t.Run("Valid struct", func(t *testing.T) {
+		applicant := validTaggedApplicant()
+		result := schema.Parse(applicant)
+		assert.True(t, result.Ok)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, applicant, result.Value)
+	})
+
+	t.Run("Valid struct pointer", func(t *testing.T) {
+		applicant := validTaggedApplicant()
+		result := schema.Parse(&applicant)
+		assert.True(t, result.Ok)
+		assert.Equal(t, applicant, result.Value)
+	})
+
+	t.Run("Invalid fields", func(t *testing.T) {
+		applicant := validTaggedApplicant()
+		applicant.Email = "not-an-email"
+		applicant.University = "MIT"
+		applicant.WAM = 101
+		applicant.Score = 7.2
+		applicant.Courseworks = []string{"COMP1511", "MATH1131"}
+		applicant.Born = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		applicant.Address.Postcode = "20000"
+		applicant.Mentor = &taggedAddress{City: "", Postcode: "2000"}
+		applicant.Nickname = nil
+		applicant.Pattern = "ABC"
+
+		result := schema.Parse(applicant)
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{
+			"email: Must be a valid email address",
+			"university: Value is not in the allowed enum set.",
+			"wam: Must be smaller than or equal to 100",
+			"Score: Must be a multiple of 0.5",
+			"courseworks: Element at index 1: Must start with 'COMP'",
+			"born: Must be earlier than or equal to 2010-01-01 00:00:00 +0000 UTC",
+			"address: postcode: Must be exactly 4 characters long",
+			"mentor: city: Must be at least 1 characters long",
+			"nickname: Is required",
+			"Pattern: Must match the required pattern",
+		}, result.Errors)
+	})
+
+	t.Run("Array directives", func(t *testing.T) {
+		applicant := validTaggedApplicant()
+		applicant.Courseworks = nil
+		result := schema.Parse(applicant)
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{"courseworks: Array must not be empty"}, result.Errors)
+	})
+
+	t.Run("Not a struct", func(t *testing.T) {
+		result := schema.Parse("Abyan")
+		assert.False(t, result.Ok)
+		assert.Contains(t, result.Errors, "Must be a struct")
+
+		result = schema.Parse((*taggedApplicant)(nil))
+		assert.False(t, result.Ok)
+	})
+}
+
+func TestStructSchema_Recursive(t *testing.T) {
+	type category struct {
+		Name     string     `v:"min=1"`
+		Children []category `v:"dive"`
+	}
+
+	schema := composites.NewStructSchema[category]("Category")
+	result := schema.ParseTyped(category{
+		Name:     "Root",
+		Children: []category{{Name: "Leaf"}, {Name: ""}},
+	})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Children: Element at index 1: Name: Must be at least 1 characters long"}, result.Errors)
+}
+
+func TestStructSchema_Paths(t *testing.T) {
+	schema := composites.NewStructSchema[taggedApplicant]("Applicant")
+	applicant := validTaggedApplicant()
+	applicant.Courseworks = []string{"COMP1511", "MATH1131"}
+	applicant.Mentor = &taggedAddress{City: "", Postcode: "2000"}
+
+	result := schema.Parse(applicant)
+	assert.False(t, result.Ok)
+	assert.Equal(t, "courseworks[1]", result.Issues[0].Path.String())
+	assert.Equal(t, "/courseworks/1", result.Issues[0].Path.Pointer())
+	assert.Equal(t, "mentor.city", result.Issues[1].Path.String())
+}
+
+func TestStructSchema_Unique(t *testing.T) {
+	type post struct {
+		Tags []string `v:"unique,dive,min=2"`
+	}
+
+	schema := composites.NewStructSchema[post]("Post")
+	assert.True(t, schema.ParseTyped(post{Tags: []string{"go", "rust"}}).Ok)
+
+	result := schema.ParseTyped(post{Tags: []string{"go", "rust", "go"}})
+	assert.Equal(t, []string{"Tags: Element at index 2: Duplicate of element at index 0"}, result.Errors)
+}
+
+func TestStructSchema_Unsigned(t *testing.T) {
+	type person struct {
+		Age   uint   `v:"min=1,max=150"`
+		Level uint8  `v:"oneof=1|2|3"`
+		Views uint64 `v:"multipleof=10"`
+	}
+
+	schema := composites.NewStructSchema[person]("Person")
+	assert.True(t, schema.ParseTyped(person{Age: 30, Level: 2, Views: 1000}).Ok)
+
+	result := schema.ParseTyped(person{Age: 0, Level: 4, Views: 15})
+	assert.Equal(t, []string{
+		"Age: Must be greater than or equal to 1",
+		"Level: Value is not in the allowed enum set.",
+		"Views: Must be a multiple of 10",
+	}, result.Errors)
+	assert.Equal(t, core.KindInteger, core.Describe(schema).Fields["Age"].Kind)
+
+	assert.Panics(t, func() {
+		type invalid struct {
+			Age uint `v:"min=-1"`
+		}
+		composites.NewStructSchema[invalid]("Invalid")
+	})
+}
+
+func TestStructSchema_AbortEarly(t *testing.T) {
+	schema := composites.NewStructSchema[taggedApplicant]("Applicant")
+
+	applicant := validTaggedApplicant()
+	applicant.Email = "not-an-email"
+	applicant.WAM = 101
+	applicant.Courseworks = []string{"MATH1131", "MATH1141"}
+
+	result := core.ParseWith[taggedApplicant](schema, applicant, core.ParseOptions{AbortEarly: true})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"email: Must be a valid email address"}, result.Errors)
+
+	applicant.Email = "abyan@example.com"
+	applicant.WAM = 85
+	result = core.ParseWith[taggedApplicant](schema, applicant, core.ParseOptions{AbortEarly: true})
+	assert.Equal(t, []string{"courseworks: Element at index 0: Must start with 'COMP'"}, result.Errors)
+}
@@ -0,0 +1,216 @@
+package composites
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+// Fields records the fields bound by the definition function of a
+// StructOfSchema. Every field is stored as an offset into the struct, so that
+// parsing reads field values directly instead of going through reflection.
+type Fields[T any] struct {
+	base       unsafe.Pointer
+	size       uintptr
+	structType reflect.Type
+	fields     []boundField[T]
+}
+
+type boundField[T any] struct {
+	name   string
+	schema interface{}
+	parse  func(value *T, state *core.State) *core.Result[interface{}]
+}
+
+func Field[T any, F any](b *Fields[T], name string, field *F, schema core.Parser[F]) {
+	offset := uintptr(unsafe.Pointer(field)) - uintptr(b.base)
+	if b.base == nil || offset >= b.size {
+		panic(fmt.Sprintf("v: field %s must point into the struct passed to the definition function", name))
+	}
+	fieldType := reflect.TypeOf((*F)(nil)).Elem()
+	if !isFieldAt(b.structType, offset, fieldType) {
+		panic(fmt.Sprintf("v: field %s does not point to a field of type %s in %s", name, fieldType, b.structType))
+	}
+
+	b.fields = append(b.fields, boundField[T]{
+		name:   name,
+		schema: schema,
+		parse: func(value *T, state *core.State) *core.Result[interface{}] {
+			fieldValue := (*F)(unsafe.Add(unsafe.Pointer(value), offset))
+			return core.ParseTypedState(schema, *fieldValue, state).ToAny()
+		},
+	})
+}
+
+// isFieldAt reports whether a struct, or a struct nested in it, declares a
+// field of the given type at the given offset.
+func isFieldAt(structType reflect.Type, offset uintptr, fieldType reflect.Type) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.Offset == offset && field.Type == fieldType {
+			return true
+		}
+		if field.Type.Kind() == reflect.Struct && offset >= field.Offset && offset < field.Offset+field.Type.Size() {
+			return isFieldAt(field.Type, offset-field.Offset, fieldType)
+		}
+	}
+	return false
+}
+
+func (b *Fields[T]) Names() []string {
+	names := make([]string, len(b.fields))
+	for i, field := range b.fields {
+		names[i] = field.name
+	}
+	return names
+}
+
+type StructOfSchema[T any] struct {
+	Schema *core.Schema[T]
+	Fields *Fields[T]
+}
+
+func NewStructOfSchema[T any](path string, define func(b *Fields[T], x *T)) *StructOfSchema[T] {
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("v: StructOf requires a struct type, got %s", structType))
+	}
+
+	x := new(T)
+	fields := &Fields[T]{base: unsafe.Pointer(x), size: unsafe.Sizeof(*x), structType: structType}
+	define(fields, x)
+	fields.base = nil
+
+	return &StructOfSchema[T]{
+		Schema: &core.Schema[T]{
+			Path:  path,
+			Rules: []core.Rule[T]{},
+		},
+		Fields: fields,
+	}
+}
+
+func (s *StructOfSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *StructOfSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, s, value)
+}
+
+func (s *StructOfSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
+	switch typedValue := value.(type) {
+	case T:
+		return s.parseTyped(typedValue, state)
+	case *T:
+		if typedValue != nil {
+			return s.parseTyped(*typedValue, state)
+		}
+	}
+
+	return s.Schema.NewTypeErrorResult(value, "struct", "Must be a struct")
+}
+
+func (s *StructOfSchema[T]) ParseTyped(value T) *core.Result[T] {
+	return s.parseTyped(value, nil)
+}
+
+func (s *StructOfSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	return s.parseTyped(value, state)
+}
+
+func (s *StructOfSchema[T]) parseTyped(value T, state *core.State) *core.Result[T] {
+	finalResult := s.Schema.NewSuccessResult()
+
+	for _, field := range s.Fields.fields {
+		fieldResult := field.parse(&value, state)
+		if !fieldResult.Ok {
+			finalResult.AddIssues(core.PrependPath(fieldResult.Issues, field.name)...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+		}
+	}
+
+	baseResult := s.Schema.ParseGenericState(value, state)
+	finalResult.AddIssues(baseResult.Issues...)
+
+	finalResult.Value = value
+	return finalResult
+}
+
+func (s *StructOfSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *StructOfSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *StructOfSchema[T]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindObject)
+	description.Fields = make(map[string]*core.Description, len(s.Fields.fields))
+	for _, field := range s.Fields.fields {
+		fieldDescription := core.Describe(field.schema)
+		description.Fields[field.name] = fieldDescription
+		if !fieldDescription.Optional {
+			description.Required = append(description.Required, field.name)
+		}
+	}
+	return description
+}
+
+func (s *StructOfSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, func() T { return value })
+}
+
+func (s *StructOfSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, defaultValue)
+}
+
+func (s *StructOfSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](s, fallback)
+}
+
+func (s *StructOfSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *StructOfSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *StructOfSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *StructOfSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *StructOfSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *StructOfSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *StructOfSchema[T]) Messages(messageFunc core.MessageFunc) *StructOfSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *StructOfSchema[T]) FailFast() *StructOfSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *StructOfSchema[T]) Description(text string) *StructOfSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *StructOfSchema[T]) Example(value T) *StructOfSchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *StructOfSchema[T]) Then() *StructOfSchema[T] {
+	s.Schema.Then()
+	return s
+}
@@ -0,0 +1,145 @@
+package composites_test
+
+import (
+	"testing"
+	"time"
+	"unsafe"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+type boundAddress struct {
+	City string
+}
+
+type boundApplicant struct {
+	Name        string
+	University  string
+	WAM         int
+	Courseworks []string
+	Born        time.Time
+	Address     boundAddress
+}
+
+func newBoundApplicantSchema() *composites.StructOfSchema[boundApplicant] {
+	address := composites.NewStructOfSchema("Address", func(b *composites.Fields[boundAddress], x *boundAddress) {
+		composites.Field(b, "City", &x.City, primitives.NewStringSchema("City").Min(1))
+	})
+
+	return composites.NewStructOfSchema("Applicant", func(b *composites.Fields[boundApplicant], x *boundApplicant) {
+		composites.Field(b, "Name", &x.Name, primitives.NewStringSchema("Name").Min(1).Max(128))
+		composites.Field(b, "University", &x.University, literals.NewEnumSchema("University", []string{"USYD", "UMELB", "UNSW"}))
+		composites.Field(b, "WAM", &x.WAM, primitives.NewNumberSchema[int]("WAM").Gte(0).Lte(100))
+		composites.Field(b, "Courseworks", &x.Courseworks, composites.NewArraySchema("Courseworks", primitives.NewStringSchema("Coursework").Min(1)).Nonempty())
+		composites.Field(b, "Born", &x.Born, primitives.NewDateSchema("Born").Max(time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)))
+		composites.Field(b, "Address", &x.Address, address)
+	})
+}
+
+func validBoundApplicant() boundApplicant {
+	return boundApplicant{
+		Name:        "Abyan",
+		University:  "UNSW",
+		WAM:         85,
+		Courseworks: []string{"COMP1511"},
+		Born:        time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Address:     boundAddress{City: "Sydney"},
+	}
+}
+
+func TestNewStructOfSchema(t *testing.T) {
+	schema := newBoundApplicantSchema()
+
+	assert.NotNil(t, schema)
+	assert.Equal(t, "Applicant", schema.Schema.Path)
+	assert.Equal(t, []string{"Name", "University", "WAM", "Courseworks", "Born", "Address"}, schema.Fields.Names())
+
+	assert.Panics(t, func() {
+		var outside string
+		composites.NewStructOfSchema("Address", func(b *composites.Fields[boundAddress], x *boundAddress) {
+			composites.Field(b, "Outside", &outside, primitives.NewStringSchema("Outside"))
+		})
+	})
+}
+
+func TestNewStructOfSchema_InvalidField(t *testing.T) {
+	assert.PanicsWithValue(t, "v: field Name does not point to a field of type int in composites_test.boundApplicant", func() {
+		composites.NewStructOfSchema("Applicant", func(b *composites.Fields[boundApplicant], x *boundApplicant) {
+			composites.Field(b, "Name", (*int)(unsafe.Pointer(&x.Name)), primitives.NewNumberSchema[int]("Name"))
+		})
+	})
+	assert.Panics(t, func() {
+		composites.NewStructOfSchema("Applicant", func(b *composites.Fields[boundApplicant], x *boundApplicant) {
+			composites.Field(b, "Name", (*string)(unsafe.Add(unsafe.Pointer(&x.Name), 1)), primitives.NewStringSchema("Name"))
+		})
+	})
+	assert.Panics(t, func() {
+		composites.NewStructOfSchema("Names", func(b *composites.Fields[[]string], x *[]string) {})
+	})
+
+	assert.NotPanics(t, func() {
+		composites.NewStructOfSchema("Applicant", func(b *composites.Fields[boundApplicant], x *boundApplicant) {
+			composites.Field(b, "City", &x.Address.City, primitives.NewStringSchema("City"))
+		})
+	})
+}
+
+func TestStructOfSchema_ParseTyped(t *testing.T) {
+	schema := newBoundApplicantSchema()
+
+	t.Run("Valid struct", func(t *testing.T) {
+		applicant := validBoundApplicant()
+		result := schema.ParseTyped(applicant)
+		assert.True(t, result.Ok)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, applicant, result.Value)
+	})
+
+	t.Run("Invalid fields", func(t *testing.T) {
+		applicant := validBoundApplicant()
+		applicant.Name = ""
+		applicant.University = "MIT"
+		applicant.WAM = -1
+		applicant.Courseworks = []string{}
+		applicant.Address.City = ""
+
+		result := schema.ParseTyped(applicant)
+		assert.False(t, result.Ok)
+		assert.Equal(t, []string{
+			"Name: Must be at least 1 characters long",
+			"University: Value is not in the allowed enum set.",
+			"WAM: Must be greater than or equal to 0",
+			"Courseworks: Array must not be empty",
+			"Address: City: Must be at least 1 characters long",
+		}, result.Errors)
+	})
+}
+
+func TestStructOfSchema_Parse(t *testing.T) {
+	schema := newBoundApplicantSchema()
+	applicant := validBoundApplicant()
+
+	assert.True(t, schema.Parse(applicant).Ok)
+	assert.True(t, schema.Parse(&applicant).Ok)
+
+	result := schema.Parse("Abyan")
+	assert.False(t, result.Ok)
+	assert.Contains(t, result.Errors, "Must be a struct")
+}
+
+func TestStructOfSchema_AbortEarly(t *testing.T) {
+	schema := composites.NewStructOfSchema("Address", func(b *composites.Fields[boundAddress], x *boundAddress) {
+		composites.Field(b, "City", &x.City, primitives.NewStringSchema("City").Min(5).Email())
+	})
+
+	result := core.ParseWith[boundAddress](schema, boundAddress{City: "abc"}, core.ParseOptions{AbortEarly: true})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+
+	assert.Len(t, schema.Parse(boundAddress{City: "abc"}).Issues, 2)
+}
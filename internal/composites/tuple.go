@@ -0,0 +1,231 @@
+package composites
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+type TupleSchema struct {
+	Schema    *core.Schema[[]interface{}]
+	Items     []core.AnyParser
+	RestItems core.AnyParser
+}
+
+func NewTupleSchema(path string, items ...core.AnyParser) *TupleSchema {
+	return &TupleSchema{
+		Schema: &core.Schema[[]interface{}]{
+			Path:  path,
+			Rules: []core.Rule[[]interface{}]{},
+		},
+		Items: items,
+	}
+}
+
+// Rest accepts any number of extra elements after the fixed ones, each parsed
+// by the given schema.
+func (s *TupleSchema) Rest(rest core.AnyParser) *TupleSchema {
+	s.RestItems = rest
+	return s
+}
+
+func (s *TupleSchema) Parse(value interface{}) *core.Result[[]interface{}] {
+	return s.ParseState(value, nil)
+}
+
+func (s *TupleSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[[]interface{}] {
+	return core.ParseContext[[]interface{}](ctx, s, value)
+}
+
+func (s *TupleSchema) ParseState(value interface{}, state *core.State) *core.Result[[]interface{}] {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return s.Schema.NewTypeErrorResult(value, "array", "Must be an array")
+	}
+
+	elements := make([]interface{}, v.Len())
+	for i := range elements {
+		elements[i] = v.Index(i).Interface()
+	}
+
+	return s.parseElements(elements, state)
+}
+
+func (s *TupleSchema) ParseTyped(elements []interface{}) *core.Result[[]interface{}] {
+	return s.parseElements(elements, nil)
+}
+
+func (s *TupleSchema) ParseTypedState(elements []interface{}, state *core.State) *core.Result[[]interface{}] {
+	return s.parseElements(elements, state)
+}
+
+func (s *TupleSchema) parseElements(elements []interface{}, state *core.State) *core.Result[[]interface{}] {
+	return s.parseItems(elements, state, func(i int, element interface{}) *core.Result[interface{}] {
+		itemSchema := s.RestItems
+		if i < len(s.Items) {
+			itemSchema = s.Items[i]
+		}
+		return core.ParseAnyState(itemSchema, element, state)
+	})
+}
+
+// parseItems checks the number of elements, parses each of them with
+// parseItem and runs the rules of the tuple once all of them are valid.
+func (s *TupleSchema) parseItems(elements []interface{}, state *core.State, parseItem func(i int, element interface{}) *core.Result[interface{}]) *core.Result[[]interface{}] {
+	if len(elements) < len(s.Items) && s.RestItems != nil {
+		return s.Schema.NewIssueResult(elements, core.Issue{
+			Code:    core.TooSmall,
+			Params:  core.Params{"type": "array", "min": len(s.Items), "inclusive": true},
+			Message: fmt.Sprintf("Tuple must have at least %d elements", len(s.Items)),
+		})
+	}
+	if len(elements) != len(s.Items) && s.RestItems == nil {
+		return s.Schema.NewIssueResult(elements, core.Issue{
+			Code:    core.InvalidLength,
+			Params:  core.Params{"type": "array", "length": len(s.Items)},
+			Message: fmt.Sprintf("Tuple must have exactly %d elements", len(s.Items)),
+		})
+	}
+
+	finalResult := s.Schema.NewSuccessResult()
+	parsedTuple := make([]interface{}, len(elements))
+
+	for i, element := range elements {
+		itemResult := parseItem(i, element)
+		if !itemResult.Ok {
+			finalResult.AddIssues(core.PrependPath(itemResult.Issues, i)...)
+			if state.AbortEarly() {
+				return finalResult
+			}
+			continue
+		}
+
+		parsedTuple[i] = itemResult.Value
+	}
+
+	if !finalResult.Ok {
+		finalResult.Value = parsedTuple
+		return finalResult
+	}
+
+	return s.Schema.ParseGenericState(parsedTuple, state)
+}
+
+func (s *TupleSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *TupleSchema) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindTuple)
+	for _, item := range s.Items {
+		description.Items = append(description.Items, core.Describe(item))
+	}
+	if s.RestItems != nil {
+		description.Rest = core.Describe(s.RestItems)
+	}
+	return description
+}
+
+func (s *TupleSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *TupleSchema) Default(value []interface{}) *core.DefaultSchema[[]interface{}] {
+	return core.NewDefaultSchema[[]interface{}](s, func() []interface{} { return value })
+}
+
+func (s *TupleSchema) DefaultFunc(defaultValue func() []interface{}) *core.DefaultSchema[[]interface{}] {
+	return core.NewDefaultSchema[[]interface{}](s, defaultValue)
+}
+
+func (s *TupleSchema) Catch(fallback []interface{}) *core.CatchSchema[[]interface{}] {
+	return core.NewCatchSchema[[]interface{}](s, fallback)
+}
+
+func (s *TupleSchema) Refine(check func([]interface{}) bool, message string, opts ...core.RefineOptions) *TupleSchema {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *TupleSchema) SuperRefine(refinement func(value []interface{}, ctx *core.RefinementCtx)) *TupleSchema {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *TupleSchema) RefineContext(check func(ctx context.Context, value []interface{}) error) *TupleSchema {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *TupleSchema) Messages(messageFunc core.MessageFunc) *TupleSchema {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *TupleSchema) FailFast() *TupleSchema {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *TupleSchema) Description(text string) *TupleSchema {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *TupleSchema) Example(value []interface{}) *TupleSchema {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *TupleSchema) Then() *TupleSchema {
+	s.Schema.Then()
+	return s
+}
+
+// typedItem validates an element of a typed tuple with the parser of its
+// position, so that already parsed values are not parsed again.
+type typedItem func(element interface{}, state *core.State) *core.Result[interface{}]
+
+func newTypedItem[T any](item core.Parser[T]) typedItem {
+	return func(element interface{}, state *core.State) *core.Result[interface{}] {
+		return core.ParseTypedState(item, as[T](element), state).ToAny()
+	}
+}
+
+func (s *TupleSchema) parseTypedItems(elements []interface{}, items []typedItem, state *core.State) *core.Result[[]interface{}] {
+	return s.parseItems(elements, state, func(i int, element interface{}) *core.Result[interface{}] {
+		return items[i](element, state)
+	})
+}
+
+// parseTypedTuple converts the elements parsed by the untyped schema of a
+// fixed-size tuple into the typed tuple U, then runs its rules.
+func parseTypedTuple[U any](s *core.Schema[U], tupleResult *core.Result[[]interface{}], state *core.State, convert func([]interface{}) U) *core.Result[U] {
+	if !tupleResult.Ok {
+		result := s.NewSuccessResult()
+		result.AddIssues(tupleResult.Issues...)
+		return result
+	}
+
+	return s.ParseGenericState(convert(tupleResult.Value), state)
+}
+
+// describeTypedTuple describes a typed tuple like its untyped schema. Examples
+// are described as arrays, which is how tuples are encoded.
+func describeTypedTuple[U any](s *core.Schema[U], tuple *TupleSchema, elements func(U) []interface{}) *core.Description {
+	description := tuple.Describe()
+	if s.Doc != "" {
+		description.Doc = s.Doc
+	}
+	for _, example := range s.Examples {
+		description.Examples = append(description.Examples, elements(example))
+	}
+	return description
+}
+
+func as[T any](value interface{}) T {
+	typedValue, _ := value.(T)
+	return typedValue
+}
@@ -0,0 +1,69 @@
+package composites_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTupleSchema(t *testing.T) {
+	schema := composites.NewTupleSchema("Row",
+		primitives.NewStringSchema("Name").Min(1),
+		primitives.NewNumberSchema[float64]("Age").Gte(0),
+		primitives.NewBooleanSchema("Active"),
+	)
+
+	result := schema.Parse([]interface{}{"Abyan", 20.0, true})
+	assert.True(t, result.Ok)
+	assert.Equal(t, []interface{}{"Abyan", 20.0, true}, result.Value)
+
+	result = schema.Parse([]interface{}{"", "twenty", true})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.Path{0}, result.Issues[0].Path)
+	assert.Equal(t, core.Path{1}, result.Issues[1].Path)
+	assert.Equal(t, []string{
+		"Element at index 0: Must be at least 1 characters long",
+		"Element at index 1: Must be a number.",
+	}, result.Errors)
+
+	result = schema.Parse([]interface{}{"Abyan", 20.0})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.InvalidLength, result.Issues[0].Code)
+	assert.Equal(t, []string{"Tuple must have exactly 3 elements"}, result.Errors)
+
+	result = schema.Parse(map[string]interface{}{})
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+}
+
+func TestTupleSchema_Rest(t *testing.T) {
+	schema := composites.NewTupleSchema("Call", primitives.NewStringSchema("Method")).
+		Rest(primitives.NewNumberSchema[float64]("Argument"))
+
+	assert.True(t, schema.Parse([]interface{}{"sum"}).Ok)
+
+	result := schema.Parse([]interface{}{"sum", 1.0, 2.0, 3.0})
+	assert.True(t, result.Ok)
+	assert.Equal(t, []interface{}{"sum", 1.0, 2.0, 3.0}, result.Value)
+
+	result = schema.Parse([]interface{}{"sum", 1.0, "two"})
+	assert.Equal(t, "/2", result.Issues[0].Path.Pointer())
+
+	result = schema.Parse([]interface{}{})
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+}
+
+func TestTupleSchema_Rules(t *testing.T) {
+	schema := composites.NewTupleSchema("Range",
+		primitives.NewNumberSchema[float64]("From"),
+		primitives.NewNumberSchema[float64]("To"),
+	).Refine(func(value []interface{}) bool {
+		return value[0].(float64) <= value[1].(float64)
+	}, "From must not exceed To")
+
+	assert.True(t, schema.Parse([2]float64{1, 2}).Ok)
+	assert.Equal(t, []string{"From must not exceed To"}, schema.Parse([]float64{2, 1}).Errors)
+}
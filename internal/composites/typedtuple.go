@@ -0,0 +1,352 @@
+package composites
+
+import (
+	"context"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+type Tuple2[A, B any] struct {
+	V0 A
+	V1 B
+}
+
+func newTuple2[A, B any](elements []interface{}) Tuple2[A, B] {
+	return Tuple2[A, B]{as[A](elements[0]), as[B](elements[1])}
+}
+
+func (t Tuple2[A, B]) elements() []interface{} {
+	return []interface{}{t.V0, t.V1}
+}
+
+type Tuple2Schema[A, B any] struct {
+	Schema *core.Schema[Tuple2[A, B]]
+	Tuple  *TupleSchema
+	items  []typedItem
+}
+
+func NewTuple2Schema[A, B any](path string, item0 core.Parser[A], item1 core.Parser[B]) *Tuple2Schema[A, B] {
+	return &Tuple2Schema[A, B]{
+		Schema: &core.Schema[Tuple2[A, B]]{
+			Path:  path,
+			Rules: []core.Rule[Tuple2[A, B]]{},
+		},
+		Tuple: NewTupleSchema(path, core.ToAnyParser(item0), core.ToAnyParser(item1)),
+		items: []typedItem{newTypedItem(item0), newTypedItem(item1)},
+	}
+}
+
+func (s *Tuple2Schema[A, B]) Parse(value interface{}) *core.Result[Tuple2[A, B]] {
+	return s.ParseState(value, nil)
+}
+
+func (s *Tuple2Schema[A, B]) ParseContext(ctx context.Context, value interface{}) *core.Result[Tuple2[A, B]] {
+	return core.ParseContext[Tuple2[A, B]](ctx, s, value)
+}
+
+func (s *Tuple2Schema[A, B]) ParseState(value interface{}, state *core.State) *core.Result[Tuple2[A, B]] {
+	return parseTypedTuple(s.Schema, s.Tuple.ParseState(value, state), state, newTuple2[A, B])
+}
+
+func (s *Tuple2Schema[A, B]) ParseTyped(value Tuple2[A, B]) *core.Result[Tuple2[A, B]] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *Tuple2Schema[A, B]) ParseTypedState(value Tuple2[A, B], state *core.State) *core.Result[Tuple2[A, B]] {
+	return parseTypedTuple(s.Schema, s.Tuple.parseTypedItems(value.elements(), s.items, state), state, newTuple2[A, B])
+}
+
+func (s *Tuple2Schema[A, B]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *Tuple2Schema[A, B]) Describe() *core.Description {
+	return describeTypedTuple(s.Schema, s.Tuple, Tuple2[A, B].elements)
+}
+
+func (s *Tuple2Schema[A, B]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *Tuple2Schema[A, B]) Default(value Tuple2[A, B]) *core.DefaultSchema[Tuple2[A, B]] {
+	return core.NewDefaultSchema[Tuple2[A, B]](s, func() Tuple2[A, B] { return value })
+}
+
+func (s *Tuple2Schema[A, B]) DefaultFunc(defaultValue func() Tuple2[A, B]) *core.DefaultSchema[Tuple2[A, B]] {
+	return core.NewDefaultSchema[Tuple2[A, B]](s, defaultValue)
+}
+
+func (s *Tuple2Schema[A, B]) Catch(fallback Tuple2[A, B]) *core.CatchSchema[Tuple2[A, B]] {
+	return core.NewCatchSchema[Tuple2[A, B]](s, fallback)
+}
+
+func (s *Tuple2Schema[A, B]) Refine(check func(Tuple2[A, B]) bool, message string, opts ...core.RefineOptions) *Tuple2Schema[A, B] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *Tuple2Schema[A, B]) SuperRefine(refinement func(value Tuple2[A, B], ctx *core.RefinementCtx)) *Tuple2Schema[A, B] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *Tuple2Schema[A, B]) RefineContext(check func(ctx context.Context, value Tuple2[A, B]) error) *Tuple2Schema[A, B] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *Tuple2Schema[A, B]) Messages(messageFunc core.MessageFunc) *Tuple2Schema[A, B] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *Tuple2Schema[A, B]) FailFast() *Tuple2Schema[A, B] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *Tuple2Schema[A, B]) Description(text string) *Tuple2Schema[A, B] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *Tuple2Schema[A, B]) Example(value Tuple2[A, B]) *Tuple2Schema[A, B] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *Tuple2Schema[A, B]) Then() *Tuple2Schema[A, B] {
+	s.Schema.Then()
+	return s
+}
+
+type Tuple3[A, B, C any] struct {
+	V0 A
+	V1 B
+	V2 C
+}
+
+func newTuple3[A, B, C any](elements []interface{}) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{as[A](elements[0]), as[B](elements[1]), as[C](elements[2])}
+}
+
+func (t Tuple3[A, B, C]) elements() []interface{} {
+	return []interface{}{t.V0, t.V1, t.V2}
+}
+
+type Tuple3Schema[A, B, C any] struct {
+	Schema *core.Schema[Tuple3[A, B, C]]
+	Tuple  *TupleSchema
+	items  []typedItem
+}
+
+func NewTuple3Schema[A, B, C any](path string, item0 core.Parser[A], item1 core.Parser[B], item2 core.Parser[C]) *Tuple3Schema[A, B, C] {
+	return &Tuple3Schema[A, B, C]{
+		Schema: &core.Schema[Tuple3[A, B, C]]{
+			Path:  path,
+			Rules: []core.Rule[Tuple3[A, B, C]]{},
+		},
+		Tuple: NewTupleSchema(path, core.ToAnyParser(item0), core.ToAnyParser(item1), core.ToAnyParser(item2)),
+		items: []typedItem{newTypedItem(item0), newTypedItem(item1), newTypedItem(item2)},
+	}
+}
+
+func (s *Tuple3Schema[A, B, C]) Parse(value interface{}) *core.Result[Tuple3[A, B, C]] {
+	return s.ParseState(value, nil)
+}
+
+func (s *Tuple3Schema[A, B, C]) ParseContext(ctx context.Context, value interface{}) *core.Result[Tuple3[A, B, C]] {
+	return core.ParseContext[Tuple3[A, B, C]](ctx, s, value)
+}
+
+func (s *Tuple3Schema[A, B, C]) ParseState(value interface{}, state *core.State) *core.Result[Tuple3[A, B, C]] {
+	return parseTypedTuple(s.Schema, s.Tuple.ParseState(value, state), state, newTuple3[A, B, C])
+}
+
+func (s *Tuple3Schema[A, B, C]) ParseTyped(value Tuple3[A, B, C]) *core.Result[Tuple3[A, B, C]] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *Tuple3Schema[A, B, C]) ParseTypedState(value Tuple3[A, B, C], state *core.State) *core.Result[Tuple3[A, B, C]] {
+	return parseTypedTuple(s.Schema, s.Tuple.parseTypedItems(value.elements(), s.items, state), state, newTuple3[A, B, C])
+}
+
+func (s *Tuple3Schema[A, B, C]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *Tuple3Schema[A, B, C]) Describe() *core.Description {
+	return describeTypedTuple(s.Schema, s.Tuple, Tuple3[A, B, C].elements)
+}
+
+func (s *Tuple3Schema[A, B, C]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *Tuple3Schema[A, B, C]) Default(value Tuple3[A, B, C]) *core.DefaultSchema[Tuple3[A, B, C]] {
+	return core.NewDefaultSchema[Tuple3[A, B, C]](s, func() Tuple3[A, B, C] { return value })
+}
+
+func (s *Tuple3Schema[A, B, C]) DefaultFunc(defaultValue func() Tuple3[A, B, C]) *core.DefaultSchema[Tuple3[A, B, C]] {
+	return core.NewDefaultSchema[Tuple3[A, B, C]](s, defaultValue)
+}
+
+func (s *Tuple3Schema[A, B, C]) Catch(fallback Tuple3[A, B, C]) *core.CatchSchema[Tuple3[A, B, C]] {
+	return core.NewCatchSchema[Tuple3[A, B, C]](s, fallback)
+}
+
+func (s *Tuple3Schema[A, B, C]) Refine(check func(Tuple3[A, B, C]) bool, message string, opts ...core.RefineOptions) *Tuple3Schema[A, B, C] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *Tuple3Schema[A, B, C]) SuperRefine(refinement func(value Tuple3[A, B, C], ctx *core.RefinementCtx)) *Tuple3Schema[A, B, C] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *Tuple3Schema[A, B, C]) RefineContext(check func(ctx context.Context, value Tuple3[A, B, C]) error) *Tuple3Schema[A, B, C] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *Tuple3Schema[A, B, C]) Messages(messageFunc core.MessageFunc) *Tuple3Schema[A, B, C] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *Tuple3Schema[A, B, C]) FailFast() *Tuple3Schema[A, B, C] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *Tuple3Schema[A, B, C]) Description(text string) *Tuple3Schema[A, B, C] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *Tuple3Schema[A, B, C]) Example(value Tuple3[A, B, C]) *Tuple3Schema[A, B, C] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *Tuple3Schema[A, B, C]) Then() *Tuple3Schema[A, B, C] {
+	s.Schema.Then()
+	return s
+}
+
+type Tuple4[A, B, C, D any] struct {
+	V0 A
+	V1 B
+	V2 C
+	V3 D
+}
+
+func newTuple4[A, B, C, D any](elements []interface{}) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{as[A](elements[0]), as[B](elements[1]), as[C](elements[2]), as[D](elements[3])}
+}
+
+func (t Tuple4[A, B, C, D]) elements() []interface{} {
+	return []interface{}{t.V0, t.V1, t.V2, t.V3}
+}
+
+type Tuple4Schema[A, B, C, D any] struct {
+	Schema *core.Schema[Tuple4[A, B, C, D]]
+	Tuple  *TupleSchema
+	items  []typedItem
+}
+
+func NewTuple4Schema[A, B, C, D any](path string, item0 core.Parser[A], item1 core.Parser[B], item2 core.Parser[C], item3 core.Parser[D]) *Tuple4Schema[A, B, C, D] {
+	return &Tuple4Schema[A, B, C, D]{
+		Schema: &core.Schema[Tuple4[A, B, C, D]]{
+			Path:  path,
+			Rules: []core.Rule[Tuple4[A, B, C, D]]{},
+		},
+		Tuple: NewTupleSchema(path, core.ToAnyParser(item0), core.ToAnyParser(item1), core.ToAnyParser(item2), core.ToAnyParser(item3)),
+		items: []typedItem{newTypedItem(item0), newTypedItem(item1), newTypedItem(item2), newTypedItem(item3)},
+	}
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Parse(value interface{}) *core.Result[Tuple4[A, B, C, D]] {
+	return s.ParseState(value, nil)
+}
+
+func (s *Tuple4Schema[A, B, C, D]) ParseContext(ctx context.Context, value interface{}) *core.Result[Tuple4[A, B, C, D]] {
+	return core.ParseContext[Tuple4[A, B, C, D]](ctx, s, value)
+}
+
+func (s *Tuple4Schema[A, B, C, D]) ParseState(value interface{}, state *core.State) *core.Result[Tuple4[A, B, C, D]] {
+	return parseTypedTuple(s.Schema, s.Tuple.ParseState(value, state), state, newTuple4[A, B, C, D])
+}
+
+func (s *Tuple4Schema[A, B, C, D]) ParseTyped(value Tuple4[A, B, C, D]) *core.Result[Tuple4[A, B, C, D]] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *Tuple4Schema[A, B, C, D]) ParseTypedState(value Tuple4[A, B, C, D], state *core.State) *core.Result[Tuple4[A, B, C, D]] {
+	return parseTypedTuple(s.Schema, s.Tuple.parseTypedItems(value.elements(), s.items, state), state, newTuple4[A, B, C, D])
+}
+
+func (s *Tuple4Schema[A, B, C, D]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Describe() *core.Description {
+	return describeTypedTuple(s.Schema, s.Tuple, Tuple4[A, B, C, D].elements)
+}
+
+func (s *Tuple4Schema[A, B, C, D]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Default(value Tuple4[A, B, C, D]) *core.DefaultSchema[Tuple4[A, B, C, D]] {
+	return core.NewDefaultSchema[Tuple4[A, B, C, D]](s, func() Tuple4[A, B, C, D] { return value })
+}
+
+func (s *Tuple4Schema[A, B, C, D]) DefaultFunc(defaultValue func() Tuple4[A, B, C, D]) *core.DefaultSchema[Tuple4[A, B, C, D]] {
+	return core.NewDefaultSchema[Tuple4[A, B, C, D]](s, defaultValue)
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Catch(fallback Tuple4[A, B, C, D]) *core.CatchSchema[Tuple4[A, B, C, D]] {
+	return core.NewCatchSchema[Tuple4[A, B, C, D]](s, fallback)
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Refine(check func(Tuple4[A, B, C, D]) bool, message string, opts ...core.RefineOptions) *Tuple4Schema[A, B, C, D] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *Tuple4Schema[A, B, C, D]) SuperRefine(refinement func(value Tuple4[A, B, C, D], ctx *core.RefinementCtx)) *Tuple4Schema[A, B, C, D] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *Tuple4Schema[A, B, C, D]) RefineContext(check func(ctx context.Context, value Tuple4[A, B, C, D]) error) *Tuple4Schema[A, B, C, D] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Messages(messageFunc core.MessageFunc) *Tuple4Schema[A, B, C, D] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *Tuple4Schema[A, B, C, D]) FailFast() *Tuple4Schema[A, B, C, D] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Description(text string) *Tuple4Schema[A, B, C, D] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Example(value Tuple4[A, B, C, D]) *Tuple4Schema[A, B, C, D] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *Tuple4Schema[A, B, C, D]) Then() *Tuple4Schema[A, B, C, D] {
+	s.Schema.Then()
+	return s
+}
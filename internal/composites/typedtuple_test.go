@@ -0,0 +1,97 @@
+package composites_test
+
+import (
+	"strconv"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuple2Schema(t *testing.T) {
+	coordinates := composites.NewTuple2Schema[float64, float64]("Coordinates",
+		primitives.NewNumberSchema[float64]("Latitude").Gte(-90).Lte(90),
+		primitives.NewNumberSchema[float64]("Longitude").Gte(-180).Lte(180),
+	)
+
+	result := coordinates.Parse([]interface{}{-33.87, 151.21})
+	assert.True(t, result.Ok)
+	assert.Equal(t, composites.Tuple2[float64, float64]{V0: -33.87, V1: 151.21}, result.Value)
+
+	result = coordinates.ParseTyped(composites.Tuple2[float64, float64]{V0: 91, V1: 0})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Element at index 0: Must be smaller than or equal to 90"}, result.Errors)
+
+	coordinates.Description("Latitude and longitude").Example(composites.Tuple2[float64, float64]{V0: -33.87, V1: 151.21})
+	description := coordinates.Describe()
+	assert.Equal(t, "Latitude and longitude", description.Doc)
+	assert.Equal(t, []interface{}{[]interface{}{-33.87, 151.21}}, description.Examples)
+}
+
+func TestTuple3Schema(t *testing.T) {
+	schema := composites.NewTuple3Schema[string, int, bool]("Row",
+		primitives.NewStringSchema("Name"),
+		primitives.NewNumberSchema[int]("Age"),
+		primitives.NewBooleanSchema("Active"),
+	).Refine(func(value composites.Tuple3[string, int, bool]) bool {
+		return value.V1 >= 18 || !value.V2
+	}, "Minors cannot be active")
+
+	result := schema.Parse([]interface{}{"Abyan", 20, true})
+	assert.True(t, result.Ok)
+	assert.Equal(t, "Abyan", result.Value.V0)
+	assert.Equal(t, 20, result.Value.V1)
+
+	assert.Equal(t, []string{"Minors cannot be active"}, schema.Parse([]interface{}{"Abyan", 12, true}).Errors)
+	assert.Equal(t, []string{"Tuple must have exactly 3 elements"}, schema.Parse([]interface{}{"Abyan"}).Errors)
+
+	schema.Example(composites.Tuple3[string, int, bool]{V0: "Abyan", V1: 20, V2: true})
+	assert.Equal(t, []interface{}{[]interface{}{"Abyan", 20, true}}, schema.Describe().Examples)
+}
+
+func TestTuple4Schema(t *testing.T) {
+	schema := composites.NewTuple4Schema[string, string, int, []string]("Args",
+		primitives.NewStringSchema("Service"),
+		primitives.NewStringSchema("Method"),
+		primitives.NewNumberSchema[int]("Timeout"),
+		composites.NewArraySchema[string]("Tags", primitives.NewStringSchema("Tag")),
+	)
+
+	result := schema.Parse([]interface{}{"users", "get", 30, []interface{}{"a", "b"}})
+	assert.True(t, result.Ok)
+	assert.Equal(t, []string{"a", "b"}, result.Value.V3)
+
+	result = schema.Parse([]interface{}{"users", "get", 30, []interface{}{"a", 1}})
+	assert.Equal(t, []string{"Element at index 3: Element at index 1: Must be a string."}, result.Errors)
+
+	schema.Example(composites.Tuple4[string, string, int, []string]{V0: "users", V1: "get", V2: 30, V3: []string{"a"}})
+	assert.Equal(t, []interface{}{[]interface{}{"users", "get", 30, []string{"a"}}}, schema.Describe().Examples)
+}
+
+func TestTypedTuple_ParseTypedTransform(t *testing.T) {
+	number := func() *core.TransformSchema[string, int] {
+		return core.NewTransformSchema[string, int](primitives.NewStringSchema("Number"), strconv.Atoi)
+	}
+	name := primitives.NewStringSchema("Name")
+
+	// Parsed values are validated as they are rather than transformed again.
+	tuple2 := composites.NewTuple2Schema[int, string]("Pair", number(), name)
+	result2 := tuple2.Parse([]interface{}{"1", "a"})
+	assert.True(t, result2.Ok)
+	assert.Equal(t, result2, tuple2.ParseTyped(result2.Value))
+
+	tuple3 := composites.NewTuple3Schema[int, string, int]("Triple", number(), name, number())
+	result3 := tuple3.Parse([]interface{}{"1", "a", "2"})
+	assert.True(t, result3.Ok)
+	assert.Equal(t, result3, tuple3.ParseTyped(result3.Value))
+
+	tuple4 := composites.NewTuple4Schema[int, string, int, string]("Quad", number(), name, number(), primitives.NewStringSchema("Code").Min(2))
+	result4 := tuple4.Parse([]interface{}{"1", "ab", "2", "cd"})
+	assert.True(t, result4.Ok)
+	assert.Equal(t, result4, tuple4.ParseTyped(result4.Value))
+
+	invalid := tuple4.ParseTyped(composites.Tuple4[int, string, int, string]{V0: 1, V1: "ab", V2: 2, V3: "c"})
+	assert.Equal(t, []string{"Element at index 3: Must be at least 2 characters long"}, invalid.Errors)
+}
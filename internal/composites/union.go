@@ -0,0 +1,136 @@
+package composites
+
+import (
+	"context"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+type UnionSchema[T any] struct {
+	Schema  *core.Schema[T]
+	Options []core.Parser[T]
+}
+
+func NewUnionSchema[T any](path string, options ...core.Parser[T]) *UnionSchema[T] {
+	return &UnionSchema[T]{
+		Schema: &core.Schema[T]{
+			Path:  path,
+			Rules: []core.Rule[T]{},
+		},
+		Options: options,
+	}
+}
+
+func (s *UnionSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *UnionSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, s, value)
+}
+
+func (s *UnionSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
+	return parseUnion(s, value, state, func(option core.Parser[T]) *core.Result[T] {
+		return core.ParseState(option, value, state)
+	})
+}
+
+func (s *UnionSchema[T]) ParseTyped(value T) *core.Result[T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *UnionSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	return parseUnion(s, value, state, func(option core.Parser[T]) *core.Result[T] {
+		return core.ParseTypedState(option, value, state)
+	})
+}
+
+// parseUnion returns the result of the first option that succeeds, or the
+// issues of every option when none does. A parse that aborts early only
+// reports the issues of the first option.
+func parseUnion[T any](s *UnionSchema[T], value interface{}, state *core.State, parseOption func(core.Parser[T]) *core.Result[T]) *core.Result[T] {
+	if len(s.Options) == 0 {
+		return s.Schema.NewIssueResult(value, core.Issue{Code: core.NotAllowed, Message: "Value is not allowed."})
+	}
+
+	finalResult := s.Schema.NewSuccessResult()
+	for _, option := range s.Options {
+		optionResult := parseOption(option)
+		if optionResult.Ok {
+			return s.Schema.ParseGenericState(optionResult.Value, state)
+		}
+		if finalResult.Ok || !state.AbortEarly() {
+			finalResult.AddIssues(optionResult.Issues...)
+		}
+	}
+
+	return finalResult
+}
+
+func (s *UnionSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *UnionSchema[T]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindUnion)
+	for _, option := range s.Options {
+		description.Options = append(description.Options, core.Describe(option))
+	}
+	return description
+}
+
+func (s *UnionSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *UnionSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, func() T { return value })
+}
+
+func (s *UnionSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, defaultValue)
+}
+
+func (s *UnionSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](s, fallback)
+}
+
+func (s *UnionSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *UnionSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *UnionSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *UnionSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *UnionSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *UnionSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *UnionSchema[T]) Messages(messageFunc core.MessageFunc) *UnionSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *UnionSchema[T]) FailFast() *UnionSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *UnionSchema[T]) Description(text string) *UnionSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *UnionSchema[T]) Example(value T) *UnionSchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *UnionSchema[T]) Then() *UnionSchema[T] {
+	s.Schema.Then()
+	return s
+}
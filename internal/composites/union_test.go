@@ -0,0 +1,51 @@
+package composites_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnionSchema(t *testing.T) {
+	schema := composites.NewUnionSchema[string]("ID",
+		primitives.NewStringSchema("ID").UUID(),
+		primitives.NewStringSchema("ID").ULID(),
+	)
+
+	result := schema.Parse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	assert.True(t, result.Ok)
+	assert.Equal(t, "01ARZ3NDEKTSV4RRFFQ69G5FAV", result.Value)
+
+	result = schema.ParseTyped("123e4567-e89b-12d3-a456-426614174000")
+	assert.True(t, result.Ok)
+
+	result = schema.Parse("nope")
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Must be a valid UUID", "Must be a valid ULID"}, result.Errors)
+	assert.Equal(t, "uuid", result.Issues[0].Params["validation"])
+	assert.Equal(t, "ulid", result.Issues[1].Params["validation"])
+}
+
+func TestUnionSchema_Rules(t *testing.T) {
+	schema := composites.NewUnionSchema[int]("Port",
+		primitives.NewNumberSchema[int]("Port"),
+		primitives.NewNumberSchema[int]("Port").Default(8080),
+	).Refine(func(value int) bool { return value != 22 }, "Port is reserved")
+
+	result := schema.Parse(nil)
+	assert.True(t, result.Ok)
+	assert.Equal(t, 8080, result.Value)
+
+	result = schema.Parse(22)
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Port is reserved"}, result.Errors)
+}
+
+func TestUnionSchema_NoOptions(t *testing.T) {
+	result := composites.NewUnionSchema[string]("Nothing").Parse("anything")
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.NotAllowed, result.Issues[0].Code)
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+func (s *Schema[T]) AddContextRule(rule ContextRule[T]) {
+	s.ContextRules = append(s.ContextRules, rule)
+}
+
+// RefineContext adds a context rule that fails whenever check returns an
+// error. Returning an Issue sets the code, path and params of the issue;
+// any other error becomes a custom issue with the error as its message.
+func (s *Schema[T]) RefineContext(check func(ctx context.Context, value T) error) {
+	s.AddContextRule(func(ctx context.Context, value T) *Result[T] {
+		if err := check(ctx, value); err != nil {
+			return s.errorResult(value, err)
+		}
+		return s.NewSuccessResult()
+	})
+}
+
+// runContextRules runs every context rule in its own goroutine and reports
+// their issues in the order the rules were added. When the context of the
+// parse is done first, a single Canceled issue is reported instead.
+func (s *Schema[T]) runContextRules(value T, state *State) []Issue {
+	ctx := state.Context()
+	if err := ctx.Err(); err != nil {
+		return s.errorResult(value, err).Issues
+	}
+
+	results := make([]*Result[T], len(s.ContextRules))
+	var wg sync.WaitGroup
+	for i, rule := range s.ContextRules {
+		wg.Add(1)
+		go func(i int, rule ContextRule[T]) {
+			defer wg.Done()
+			results[i] = rule(ctx, value)
+		}(i, rule)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return s.errorResult(value, ctx.Err()).Issues
+	}
+
+	var issues []Issue
+	for _, result := range results {
+		if !result.Ok {
+			issues = append(issues, issuesOf(result, value)...)
+			if s.FailFast || state.AbortEarly() {
+				break
+			}
+		}
+	}
+	return issues
+}
+
+// errorResult turns the error of a context rule into a failed result. Like
+// the message of Refine, the message of a returned Issue is a template.
+func (s *Schema[T]) errorResult(value T, err error) *Result[T] {
+	var issue Issue
+	switch {
+	case errors.As(err, &issue):
+		if issue.Code == "" {
+			issue.Code = Custom
+		}
+		return s.NewIssueResult(value, issue, issue.Message)
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		issue = Issue{Code: Canceled, Params: Params{"reason": err.Error()}, Message: fmt.Sprintf("Validation did not finish: %v", err)}
+	default:
+		issue = Issue{Code: Custom, Message: err.Error()}
+	}
+	return s.NewIssueResult(value, issue)
+}
@@ -0,0 +1,148 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+type contextKey struct{}
+
+func TestRefineContext(t *testing.T) {
+	schema := primitives.NewStringSchema("username").Min(3).
+		RefineContext(func(ctx context.Context, value string) error {
+			if value == "root" {
+				return core.Issue{Code: "reserved", Params: core.Params{"username": value}, Message: "{username} is reserved"}
+			}
+			return nil
+		}).
+		RefineContext(func(ctx context.Context, value string) error {
+			if value == "root" || value == "admin" {
+				return errors.New("Username is taken")
+			}
+			return nil
+		})
+
+	assert.True(t, schema.Parse("abyan").Ok)
+
+	result := schema.Parse("root")
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"root is reserved", "Username is taken"}, result.Errors)
+	assert.Equal(t, "reserved", result.Issues[0].Code)
+	assert.Equal(t, core.Custom, result.Issues[1].Code)
+
+	called := false
+	schema.RefineContext(func(ctx context.Context, value string) error {
+		called = true
+		return nil
+	})
+	result = schema.Parse("ab")
+	assert.Len(t, result.Issues, 1)
+	assert.False(t, called)
+}
+
+func TestRefineContext_FailFast(t *testing.T) {
+	schema := primitives.NewStringSchema("username").FailFast()
+	for _, message := range []string{"first", "second"} {
+		err := errors.New(message)
+		schema.RefineContext(func(ctx context.Context, value string) error {
+			return err
+		})
+	}
+
+	assert.Equal(t, []string{"first"}, schema.Parse("abyan").Errors)
+}
+
+func TestRefineContext_Concurrent(t *testing.T) {
+	ping, pong := make(chan struct{}), make(chan struct{})
+	schema := primitives.NewStringSchema("username").
+		RefineContext(func(ctx context.Context, value string) error {
+			close(ping)
+			select {
+			case <-pong:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}).
+		RefineContext(func(ctx context.Context, value string) error {
+			close(pong)
+			select {
+			case <-ping:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.True(t, schema.ParseContext(ctx, "abyan").Ok)
+}
+
+func TestRefineContext_Deadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	schema := primitives.NewStringSchema("username").RefineContext(func(ctx context.Context, value string) error {
+		<-release
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	result := schema.ParseContext(ctx, "abyan")
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.Canceled, result.Issues[0].Code)
+	assert.Equal(t, "Validation did not finish: context deadline exceeded", result.Issues[0].Message)
+
+	result = schema.ParseContext(ctx, "abyan")
+	assert.Equal(t, core.Canceled, result.Issues[0].Code)
+}
+
+func TestParseContext_Nested(t *testing.T) {
+	var received []interface{}
+	tag := primitives.NewStringSchema("tag").RefineContext(func(ctx context.Context, value string) error {
+		received = append(received, ctx.Value(contextKey{}))
+		return nil
+	})
+	schema := composites.NewObjectSchema("post", composites.Shape{
+		"tags": composites.NewArraySchema[string]("tags", core.NewOptionalSchema[string](tag)),
+	})
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "request")
+	result := schema.ParseContext(ctx, map[string]interface{}{"tags": []interface{}{"go", "rust"}})
+	assert.True(t, result.Ok)
+	assert.Equal(t, []interface{}{"request", "request"}, received)
+
+	received = nil
+	assert.True(t, schema.Parse(map[string]interface{}{"tags": []interface{}{"go"}}).Ok)
+	assert.Equal(t, []interface{}{nil}, received)
+}
+
+type post struct {
+	Tags []string
+}
+
+func TestParseContext_StructOf(t *testing.T) {
+	var received []interface{}
+	tag := primitives.NewStringSchema("tag").RefineContext(func(ctx context.Context, value string) error {
+		received = append(received, ctx.Value(contextKey{}))
+		return nil
+	})
+	schema := composites.NewStructOfSchema("post", func(b *composites.Fields[post], x *post) {
+		composites.Field(b, "Tags", &x.Tags, composites.NewArraySchema[string]("tags", tag))
+	})
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "request")
+	assert.True(t, schema.ParseContext(ctx, post{Tags: []string{"go", "rust"}}).Ok)
+	assert.Equal(t, []interface{}{"request", "request"}, received)
+}
@@ -1,27 +1,122 @@
 package core
 
+import "context"
+
 type Result[T any] struct {
 	Ok     bool
 	Value  T
 	Path   string
 	Errors []string
+	Issues []Issue
 }
 
 type Rule[T any] func(T) *Result[T]
 
+// ContextRule is a rule that needs the context of the parse, typically
+// because it calls out to a database or another service.
+type ContextRule[T any] func(ctx context.Context, value T) *Result[T]
+
+// Parser parses raw input with Parse. ParseTyped validates a value that
+// already has the output type of the schema, so stages that only shape input,
+// such as transforms and preprocessing, are skipped.
+type Parser[T any] interface {
+	Parse(value interface{}) *Result[T]
+	ParseTyped(value T) *Result[T]
+}
+
+type AnyParser interface {
+	ParseAny(value interface{}) *Result[interface{}]
+}
+
 type Schema[T any] struct {
-	Path  string
-	Rules []Rule[T]
+	Path        string
+	Rules       []Rule[T]
+	MessageFunc MessageFunc
+	// ContextRules run concurrently once every rule in Rules passed.
+	ContextRules []ContextRule[T]
+	// Checks describe the built-in rules among Rules.
+	Checks []Check
+	// FailFast stops running rules once one of them failed.
+	FailFast bool
+	// Doc and Examples document the schema for exporters and do not affect
+	// parsing.
+	Doc      string
+	Examples []T
+
+	// barriers are the indexes of the rules that only run when every rule
+	// before them passed.
+	barriers []int
 }
 
 type CoerceSchema[T any] struct {
 	Inner Schema[T]
 }
 
+// ToAnyParser adapts a typed parser for places that mix values of several
+// types, such as the fields of an object.
+func ToAnyParser[T any](parser Parser[T]) AnyParser {
+	if anyParser, isAnyParser := parser.(AnyParser); isAnyParser {
+		return anyParser
+	}
+	return anyParser[T]{parser}
+}
+
+type anyParser[T any] struct {
+	Parser[T]
+}
+
+func (p anyParser[T]) ParseAny(value interface{}) *Result[interface{}] {
+	return p.Parse(value).ToAny()
+}
+
+func (r *Result[T]) ToAny() *Result[interface{}] {
+	return &Result[interface{}]{
+		Ok:     r.Ok,
+		Value:  r.Value,
+		Path:   r.Path,
+		Errors: r.Errors,
+		Issues: r.Issues,
+	}
+}
+
+// AddIssues marks the result as failed and records the issues, keeping Errors
+// in sync as the human-readable view of Issues.
+func (r *Result[T]) AddIssues(issues ...Issue) {
+	for _, issue := range issues {
+		r.Ok = false
+		r.Issues = append(r.Issues, issue)
+		r.Errors = append(r.Errors, issue.Error())
+	}
+}
+
+// ErrorsByPointer groups the messages of every issue by the JSON Pointer of
+// the value at fault, which is handy for highlighting fields in forms.
+func (r *Result[T]) ErrorsByPointer() map[string][]string {
+	errorsByPointer := make(map[string][]string, len(r.Issues))
+	for _, issue := range r.Issues {
+		pointer := issue.Path.Pointer()
+		errorsByPointer[pointer] = append(errorsByPointer[pointer], issue.Message)
+	}
+	return errorsByPointer
+}
+
 func (s *Schema[T]) AddRule(rule Rule[T]) {
 	s.Rules = append(s.Rules, rule)
 }
 
+// AddCheck adds a rule along with its description, so that the rule can be
+// exported to formats such as JSON Schema.
+func (s *Schema[T]) AddCheck(check Check, rule Rule[T]) {
+	s.Checks = append(s.Checks, check)
+	s.AddRule(rule)
+}
+
+// Then makes the rules added from now on depend on the rules added so far:
+// they only run when every rule before them passed.
+func (s *Schema[T]) Then() {
+	s.barriers = append(s.barriers, len(s.Rules))
+}
+
 func (s *Schema[T]) NewSuccessResult() *Result[T] {
 	return &Result[T]{
 		Ok:   true,
@@ -30,24 +125,88 @@ func (s *Schema[T]) NewSuccessResult() *Result[T] {
 }
 
 func (s *Schema[T]) NewErrorResult(errorMessage string) *Result[T] {
-	return &Result[T]{
-		Ok:     false,
-		Path:   s.Path,
-		Errors: []string{errorMessage},
+	return s.NewIssueResult(nil, Issue{Code: Custom, Message: errorMessage})
+}
+
+// NewIssueResult builds a failed result for the issue. An optional message
+// overrides the default one and may use placeholders such as {path}, {value}
+// and the keys of the issue params.
+func (s *Schema[T]) NewIssueResult(value interface{}, issue Issue, message ...string) *Result[T] {
+	if issue.Received == "" && value != nil {
+		issue.Received = TypeName(value)
 	}
+	issue.Message, issue.Overridden = s.message(issue, value, message)
+
+	result := &Result[T]{Path: s.Path}
+	result.AddIssues(issue)
+	return result
+}
+
+func (s *Schema[T]) NewTypeErrorResult(value interface{}, expected string, errorMessage string) *Result[T] {
+	return s.NewIssueResult(value, Issue{
+		Code:     InvalidType,
+		Params:   Params{"expected": expected},
+		Received: TypeName(value),
+		Message:  errorMessage,
+	})
 }
 
 func (s *Schema[T]) ParseGeneric(value T) *Result[T] {
+	return s.ParseGenericState(value, nil)
+}
+
+// ParseGenericState runs the rules like ParseGeneric, but stops at the first
+// failing rule when the schema fails fast or the parse aborts early.
+func (s *Schema[T]) ParseGenericState(value T, state *State) *Result[T] {
 	finalResult := s.NewSuccessResult()
-	for _, assertRule := range s.Rules {
+	for i, assertRule := range s.Rules {
+		if !finalResult.Ok && s.isBarrier(i) {
+			break
+		}
+
 		assertionResult := assertRule(value)
 		if !assertionResult.Ok {
-			finalResult.Ok = false
-			finalResult.Errors = append(finalResult.Errors, assertionResult.Errors...)
+			finalResult.AddIssues(issuesOf(assertionResult, value)...)
+			if s.FailFast || state.AbortEarly() {
+				break
+			}
 		}
 	}
 
+	if finalResult.Ok && len(s.ContextRules) > 0 {
+		finalResult.AddIssues(s.runContextRules(value, state)...)
+	}
+
 	finalResult.Value = value
 
 	return finalResult
 }
+
+func (s *Schema[T]) isBarrier(index int) bool {
+	for _, barrier := range s.barriers {
+		if barrier == index {
+			return true
+		}
+	}
+	return false
+}
+
+// issuesOf returns the issues of a failed result, falling back to custom
+// issues for results that were built by hand with Errors only.
+func issuesOf[T any](result *Result[T], value interface{}) []Issue {
+	issues := make([]Issue, 0, len(result.Errors))
+	if len(result.Issues) > 0 {
+		issues = append(issues, result.Issues...)
+	} else {
+		for _, errorMessage := range result.Errors {
+			issues = append(issues, Issue{Code: Custom, Message: errorMessage})
+		}
+	}
+
+	for i := range issues {
+		if issues[i].Received == "" {
+			issues[i].Received = TypeName(value)
+		}
+	}
+	return issues
+}
@@ -37,6 +37,21 @@ func TestNewErrorResult(t *testing.T) {
 	assert.Equal(t, "abyan has a majestic cat", result.Path)
 	assert.Equal(t, []string{errorMessage}, result.Errors)
 }
+
+func TestResultToAny(t *testing.T) {
+	schema := &core.Schema[int]{Path: "test123"}
+	result := schema.ParseGeneric(42).ToAny()
+
+	assert.True(t, result.Ok)
+	assert.Equal(t, 42, result.Value)
+	assert.Equal(t, "test123", result.Path)
+
+	result = schema.NewErrorResult("bad").ToAny()
+	assert.False(t, result.Ok)
+	assert.Equal(t, 0, result.Value)
+	assert.Equal(t, []string{"bad"}, result.Errors)
+}
+
 func TestParseGeneric(t *testing.T) {
 	schema := &core.Schema[int]{Path: "test123"}
 
@@ -65,3 +80,44 @@ func TestParseGeneric(t *testing.T) {
 	assert.Equal(t, 42, result.Value)
 	assert.Equal(t, []string{"error with value 42"}, result.Errors)
 }
+
+func TestParseGeneric_FailFast(t *testing.T) {
+	schema := &core.Schema[int]{Path: "test123"}
+	for _, message := range []string{"first", "second"} {
+		message := message
+		schema.AddRule(func(value int) *core.Result[int] {
+			return schema.NewErrorResult(message)
+		})
+	}
+
+	assert.Equal(t, []string{"first", "second"}, schema.ParseGeneric(42).Errors)
+
+	schema.FailFast = true
+	assert.Equal(t, []string{"first"}, schema.ParseGeneric(42).Errors)
+
+	schema.FailFast = false
+	result := schema.ParseGenericState(42, core.NewState(core.ParseOptions{AbortEarly: true}))
+	assert.Equal(t, []string{"first"}, result.Errors)
+}
+
+func TestParseGeneric_Then(t *testing.T) {
+	schema := &core.Schema[int]{Path: "test123"}
+	rule := func(message string, check func(int) bool) core.Rule[int] {
+		return func(value int) *core.Result[int] {
+			if check(value) {
+				return schema.NewSuccessResult()
+			}
+			return schema.NewErrorResult(message)
+		}
+	}
+
+	schema.AddRule(rule("positive", func(value int) bool { return value > 0 }))
+	schema.AddRule(rule("even", func(value int) bool { return value%2 == 0 }))
+	schema.Then()
+	schema.AddRule(rule("small", func(value int) bool { return value < 10 }))
+
+	assert.True(t, schema.ParseGeneric(4).Ok)
+	assert.Equal(t, []string{"small"}, schema.ParseGeneric(12).Errors)
+	assert.Equal(t, []string{"even"}, schema.ParseGeneric(13).Errors)
+	assert.Equal(t, []string{"positive", "even"}, schema.ParseGeneric(-3).Errors)
+}
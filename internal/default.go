@@ -0,0 +1,57 @@
+package core
+
+import "context"
+
+// DefaultSchema substitutes a default value when the value is nil or absent
+// from its parent. The default value is parsed by the inner schema as well.
+type DefaultSchema[T any] struct {
+	Inner        Parser[T]
+	DefaultValue func() T
+}
+
+func NewDefaultSchema[T any](inner Parser[T], defaultValue func() T) *DefaultSchema[T] {
+	return &DefaultSchema[T]{Inner: inner, DefaultValue: defaultValue}
+}
+
+func (s *DefaultSchema[T]) Parse(value interface{}) *Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *DefaultSchema[T]) ParseContext(ctx context.Context, value interface{}) *Result[T] {
+	return ParseContext[T](ctx, s, value)
+}
+
+func (s *DefaultSchema[T]) ParseState(value interface{}, state *State) *Result[T] {
+	if isNil(value) {
+		return ParseTypedState(s.Inner, s.DefaultValue(), state)
+	}
+
+	return ParseState(s.Inner, value, state)
+}
+
+func (s *DefaultSchema[T]) ParseTyped(value T) *Result[T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *DefaultSchema[T]) ParseTypedState(value T, state *State) *Result[T] {
+	return ParseTypedState(s.Inner, value, state)
+}
+
+func (s *DefaultSchema[T]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *DefaultSchema[T]) Describe() *Description {
+	description := Describe(s.Inner)
+	description.HasDefault = true
+	description.Default = s.DefaultValue()
+	return description
+}
+
+func (s *DefaultSchema[T]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *DefaultSchema[T]) ParseAbsent(state *State) (*Result[interface{}], bool) {
+	return ParseTypedState(s.Inner, s.DefaultValue(), state).ToAny(), true
+}
@@ -0,0 +1,57 @@
+package core_test
+
+import (
+	"regexp"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultSchema(t *testing.T) {
+	schema := core.NewDefaultSchema[string](primitives.NewStringSchema("Role").Min(4), func() string { return "member" })
+
+	result := schema.Parse(nil)
+	assert.True(t, result.Ok)
+	assert.Equal(t, "member", result.Value)
+
+	result = schema.Parse("admin")
+	assert.True(t, result.Ok)
+	assert.Equal(t, "admin", result.Value)
+
+	result = schema.ParseTyped("abc")
+	assert.False(t, result.Ok)
+
+	absentResult, present := schema.ParseAbsent(nil)
+	assert.True(t, absentResult.Ok)
+	assert.True(t, present)
+	assert.Equal(t, "member", absentResult.Value)
+}
+
+func TestDefaultSchema_InvalidDefault(t *testing.T) {
+	schema := core.NewDefaultSchema[int](primitives.NewNumberSchema[int]("Age").Positive(), func() int { return 0 })
+
+	result := schema.Parse(nil)
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Must be a positive number"}, result.Errors)
+}
+
+func TestDefaultSchema_NilPointer(t *testing.T) {
+	schema := core.NewDefaultSchema[string](primitives.NewStringSchema("Role"), func() string { return "member" })
+
+	var role *string
+	result := schema.Parse(role)
+	assert.True(t, result.Ok)
+	assert.Equal(t, "member", result.Value)
+}
+
+func TestDefaultSchema_ParseAbsentState(t *testing.T) {
+	schema := core.NewDefaultSchema[string](primitives.NewStringSchema("Code").Min(4).Regex(regexp.MustCompile("^[0-9]+$")), func() string { return "ab" })
+
+	absentResult, _ := schema.ParseAbsent(nil)
+	assert.Len(t, absentResult.Issues, 2)
+
+	absentResult, _ = schema.ParseAbsent(core.NewState(core.ParseOptions{AbortEarly: true}))
+	assert.Len(t, absentResult.Issues, 1)
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Definitions keeps track of the schemas that exporters turn into shared
+// definitions. Lazy descriptions are resolved once, lazy schemas that refer
+// back to themselves are marked as recursive, and every definition gets a
+// unique name.
+type Definitions struct {
+	resolved  map[interface{}]*Description
+	recursive map[interface{}]bool
+	names     map[interface{}]string
+	taken     map[string]bool
+}
+
+func NewDefinitions() *Definitions {
+	return &Definitions{
+		resolved:  map[interface{}]*Description{},
+		recursive: map[interface{}]bool{},
+		names:     map[interface{}]string{},
+		taken:     map[string]bool{},
+	}
+}
+
+// Define names a description, so that Reference returns the name for every
+// use of the schema it describes.
+func (d *Definitions) Define(name string, description *Description) {
+	d.taken[name] = true
+	if description.Ref != nil {
+		d.names[description.Ref] = name
+	}
+}
+
+// Resolve returns the description a lazy description resolves to.
+func (d *Definitions) Resolve(description *Description) *Description {
+	if resolved, isResolved := d.resolved[description.Ref]; isResolved {
+		return resolved
+	}
+	resolved := description.Resolve()
+	d.resolved[description.Ref] = resolved
+	return resolved
+}
+
+// FindRecursive resolves the lazy descriptions nested in a description, and
+// marks those met again while being resolved as recursive.
+func (d *Definitions) FindRecursive(description *Description) {
+	d.findRecursive(description, map[interface{}]bool{})
+}
+
+func (d *Definitions) findRecursive(description *Description, resolving map[interface{}]bool) {
+	if description == nil {
+		return
+	}
+
+	if description.Kind == KindLazy {
+		if resolving[description.Ref] {
+			d.recursive[description.Ref] = true
+			return
+		}
+		if _, isResolved := d.resolved[description.Ref]; isResolved {
+			return
+		}
+		resolving[description.Ref] = true
+		d.findRecursive(d.Resolve(description), resolving)
+		delete(resolving, description.Ref)
+		return
+	}
+
+	nested := []*Description{description.Element, description.Key, description.Rest}
+	nested = append(nested, description.Items...)
+	nested = append(nested, description.Options...)
+	for _, field := range description.Fields {
+		nested = append(nested, field)
+	}
+	for _, child := range nested {
+		d.findRecursive(child, resolving)
+	}
+}
+
+func (d *Definitions) IsRecursive(description *Description) bool {
+	return d.recursive[description.Ref]
+}
+
+// Reference returns the name of the definition a description refers to,
+// either directly or through a lazy schema.
+func (d *Definitions) Reference(description *Description) (string, bool) {
+	if name, isNamed := d.names[description.Ref]; isNamed && description.Ref != nil {
+		return name, true
+	}
+	if description.Kind == KindLazy {
+		resolved := d.Resolve(description)
+		if name, isNamed := d.names[resolved.Ref]; isNamed && resolved.Ref != nil {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// NameRecursive names a recursive lazy description after the struct type or
+// path it was declared with, or "Schema" when that name is not valid, and
+// numbers it when the name is already taken.
+func (d *Definitions) NameRecursive(description *Description, valid func(name string) bool) string {
+	name := d.Resolve(description).Path
+	if structType, isType := description.Ref.(reflect.Type); isType && structType.Name() != "" {
+		name = structType.Name()
+	}
+	if !valid(name) {
+		name = "Schema"
+	}
+
+	unique := name
+	for i := 2; d.taken[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	d.taken[unique] = true
+	d.names[description.Ref] = unique
+	return unique
+}
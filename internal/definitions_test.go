@@ -0,0 +1,40 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefinitions(t *testing.T) {
+	category, lazyCategory := newCategorySchema()
+	defs := core.NewDefinitions()
+
+	description := core.Describe(lazyCategory)
+	defs.FindRecursive(description)
+	assert.True(t, defs.IsRecursive(description))
+	assert.Equal(t, core.KindObject, defs.Resolve(description).Kind)
+
+	_, isNamed := defs.Reference(description)
+	assert.False(t, isNamed)
+
+	valid := func(name string) bool { return name != "" }
+	assert.Equal(t, "Category", defs.NameRecursive(description, valid))
+	name, isNamed := defs.Reference(description)
+	assert.True(t, isNamed)
+	assert.Equal(t, "Category", name)
+
+	other := core.Describe(core.NewLazySchema(func() core.Parser[map[string]interface{}] {
+		return composites.NewObjectSchema("", composites.Shape{"child": lazyCategory})
+	}))
+	defs.FindRecursive(other)
+	assert.False(t, defs.IsRecursive(other))
+	assert.Equal(t, "Schema", defs.NameRecursive(other, valid))
+
+	defs.Define("Tree", core.Describe(category))
+	assert.Equal(t, "Tree2", defs.NameRecursive(core.Describe(core.NewLazySchema(func() core.Parser[map[string]interface{}] {
+		return composites.NewObjectSchema("Tree", composites.Shape{})
+	})), valid))
+}
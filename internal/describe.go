@@ -0,0 +1,131 @@
+package core
+
+import "reflect"
+
+// Check describes a built-in rule by the code and params of the issue it
+// reports, e.g. {too_small {type: string, min: 3, inclusive: true}}.
+type Check struct {
+	Code   string
+	Params Params
+}
+
+const (
+	KindAny          = "any"
+	KindNever        = "never"
+	KindNil          = "nil"
+	KindString       = "string"
+	KindNumber       = "number"
+	KindInteger      = "integer"
+	KindBoolean      = "boolean"
+	KindDate         = "date"
+	KindLiteral      = "literal"
+	KindEnum         = "enum"
+	KindArray        = "array"
+	KindSet          = "set"
+	KindTuple        = "tuple"
+	KindObject       = "object"
+	KindRecord       = "record"
+	KindUnion        = "union"
+	KindIntersection = "intersection"
+	KindLazy         = "lazy"
+)
+
+// Description is a plain data view of a schema, which exporters turn into
+// formats such as JSON Schema. Rules added through Refine and other closures
+// are opaque and therefore not described.
+type Description struct {
+	Kind   string
+	Path   string
+	Checks []Check
+
+	// Values holds the allowed values of enums and the value of literals.
+	Values []interface{}
+
+	// Element describes the elements of arrays and sets and the values of
+	// records, whose keys are described by Key.
+	Element *Description
+	Key     *Description
+
+	// Fields describes the keys of objects and structs. Required lists the
+	// keys that must be present, in order.
+	Fields      map[string]*Description
+	Required    []string
+	UnknownKeys bool
+
+	// Items and Rest describe the elements of tuples.
+	Items []*Description
+	Rest  *Description
+
+	// Options describes the members of unions and intersections. Union
+	// options that share a Discriminator key form a discriminated union.
+	Options       []*Description
+	Discriminator string
+
+	Doc      string
+	Examples []interface{}
+
+	Optional   bool
+	Nullable   bool
+	HasDefault bool
+	Default    interface{}
+
+	// Ref identifies the schema or struct type the description was built
+	// from, so that exporters can share one definition between its uses.
+	// Lazy descriptions are only built when Resolve is called, so that
+	// recursive schemas can be described.
+	Ref     interface{}
+	Resolve func() *Description
+}
+
+// Describer is implemented by schemas that can describe themselves.
+type Describer interface {
+	Describe() *Description
+}
+
+// Describe describes a schema, or returns an any description when the schema
+// cannot describe itself.
+func Describe(schema interface{}) *Description {
+	describer, isDescriber := schema.(Describer)
+	if !isDescriber {
+		return &Description{Kind: KindAny}
+	}
+
+	description := describer.Describe()
+	if description.Ref == nil && reflect.ValueOf(schema).Kind() == reflect.Ptr {
+		description.Ref = schema
+	}
+	return description
+}
+
+// KindOf returns the kind of values of type T, as used by enums and literals.
+func KindOf[T any]() string {
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.String:
+		return KindString
+	case reflect.Bool:
+		return KindBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return KindInteger
+	case reflect.Float32, reflect.Float64:
+		return KindNumber
+	}
+	return KindAny
+}
+
+// Describe describes the schema as the given kind along with its checks.
+func (s *Schema[T]) Describe(kind string) *Description {
+	return s.Document(&Description{Kind: kind, Path: s.Path, Checks: append([]Check(nil), s.Checks...)})
+}
+
+// Document copies the documentation of the schema onto a description, for
+// schemas that are described by another schema.
+func (s *Schema[T]) Document(description *Description) *Description {
+	if s.Doc != "" {
+		description.Doc = s.Doc
+	}
+	for _, example := range s.Examples {
+		description.Examples = append(description.Examples, example)
+	}
+	return description
+}
@@ -0,0 +1,45 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribe(t *testing.T) {
+	schema := primitives.NewStringSchema("username").Min(3).Refine(func(value string) bool {
+		return value != "root"
+	}, "Username is reserved")
+
+	description := core.Describe(core.NewNullableSchema[string](core.NewOptionalSchema[string](schema)))
+	assert.Equal(t, core.KindString, description.Kind)
+	assert.True(t, description.Optional)
+	assert.True(t, description.Nullable)
+	assert.Equal(t, []core.Check{{
+		Code:   core.TooSmall,
+		Params: core.Params{"type": "string", "min": 3, "inclusive": true},
+	}}, description.Checks)
+
+	description = core.Describe(core.NewDefaultSchema[int](primitives.NewNumberSchema[int]("port"), func() int { return 8080 }))
+	assert.Equal(t, core.KindInteger, description.Kind)
+	assert.True(t, description.HasDefault)
+	assert.Equal(t, 8080, description.Default)
+
+	assert.Equal(t, core.KindAny, core.Describe(struct{}{}).Kind)
+}
+
+func TestDescribe_Lazy(t *testing.T) {
+	_, lazyCategory := newCategorySchema()
+
+	description := lazyCategory.Describe()
+	assert.Equal(t, core.KindLazy, description.Kind)
+	assert.Equal(t, lazyCategory, description.Ref)
+
+	resolved := description.Resolve()
+	assert.Equal(t, core.KindObject, resolved.Kind)
+	assert.Equal(t, "Category", resolved.Path)
+	assert.Equal(t, []string{"children", "name"}, resolved.Required)
+	assert.Equal(t, core.KindLazy, resolved.Fields["children"].Element.Kind)
+}
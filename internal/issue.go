@@ -0,0 +1,118 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	Custom               = "custom"
+	InvalidType          = "invalid_type"
+	InvalidDate          = "invalid_date"
+	InvalidString        = "invalid_string"
+	InvalidLength        = "invalid_length"
+	InvalidLiteral       = "invalid_literal"
+	TooSmall             = "too_small"
+	TooBig               = "too_big"
+	NotMultipleOf        = "not_multiple_of"
+	NotFinite            = "not_finite"
+	NotInEnum            = "not_in_enum"
+	NotAllowed           = "not_allowed"
+	Required             = "required"
+	UnrecognizedKey      = "unrecognized_key"
+	InvalidDiscriminator = "invalid_discriminator"
+	InvalidIntersection  = "invalid_intersection"
+	NotUnique            = "not_unique"
+	MissingElement       = "missing_element"
+	TooDeep              = "too_deep"
+	Cycle                = "cycle"
+	Canceled             = "canceled"
+	NotFound             = "not_found"
+	AlreadyExists        = "already_exists"
+)
+
+type Params map[string]interface{}
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Path holds the keys (strings) and indexes (ints) leading from the parsed
+// value to a nested value. Composites prepend their own segment to the issues
+// of their children, so paths are built automatically while descending.
+type Path []interface{}
+
+// Issue describes a single failed check. Code and Params are stable and meant
+// to be inspected by programs, whereas Message is meant to be read by humans.
+type Issue struct {
+	Code     string
+	Path     Path
+	Params   Params
+	Received string
+	Message  string
+	// Overridden reports that the message was picked by a per-rule message or
+	// a MessageFunc, so Localize leaves it as is.
+	Overridden bool
+}
+
+func (i Issue) Error() string {
+	var b strings.Builder
+	for _, segment := range i.Path {
+		if index, isIndex := segment.(int); isIndex {
+			fmt.Fprintf(&b, "Element at index %d: ", index)
+		} else {
+			fmt.Fprintf(&b, "%v: ", segment)
+		}
+	}
+	b.WriteString(i.Message)
+	return b.String()
+}
+
+func PrependPath(issues []Issue, segment interface{}) []Issue {
+	prefixed := make([]Issue, len(issues))
+	for i, issue := range issues {
+		issue.Path = append(Path{segment}, issue.Path...)
+		prefixed[i] = issue
+	}
+	return prefixed
+}
+
+// String renders the path in dotted notation, e.g., Applicants[3].Email.
+func (p Path) String() string {
+	var b strings.Builder
+	for _, segment := range p {
+		switch segment := segment.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", segment)
+		default:
+			key := fmt.Sprint(segment)
+			if !identifierRegex.MatchString(key) {
+				fmt.Fprintf(&b, "[%q]", key)
+				continue
+			}
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(key)
+		}
+	}
+	return b.String()
+}
+
+// Pointer renders the path as an RFC 6901 JSON Pointer, e.g., /Applicants/3/Email.
+func (p Path) Pointer() string {
+	var b strings.Builder
+	for _, segment := range p {
+		b.WriteByte('/')
+		b.WriteString(pointerEscaper.Replace(fmt.Sprint(segment)))
+	}
+	return b.String()
+}
+
+func TypeName(value interface{}) string {
+	if value == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,115 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueError(t *testing.T) {
+	issue := core.Issue{Code: core.Custom, Message: "Must be a cat"}
+	assert.Equal(t, "Must be a cat", issue.Error())
+
+	issue.Path = core.Path{"cats", 3, "name"}
+	assert.Equal(t, "cats: Element at index 3: name: Must be a cat", issue.Error())
+}
+
+func TestPrependPath(t *testing.T) {
+	issues := []core.Issue{
+		{Code: core.Custom, Message: "a"},
+		{Code: core.Custom, Path: core.Path{"name"}, Message: "b"},
+	}
+
+	prefixed := core.PrependPath(issues, 2)
+	assert.Equal(t, core.Path{2}, prefixed[0].Path)
+	assert.Equal(t, core.Path{2, "name"}, prefixed[1].Path)
+	assert.Equal(t, core.Path{"name"}, issues[1].Path)
+}
+
+func TestTypeName(t *testing.T) {
+	assert.Equal(t, "nil", core.TypeName(nil))
+	assert.Equal(t, "string", core.TypeName("abyan"))
+	assert.Equal(t, "[]int", core.TypeName([]int{1}))
+}
+
+func TestNewIssueResult(t *testing.T) {
+	schema := &core.Schema[string]{Path: "Name"}
+	result := schema.NewIssueResult("abc", core.Issue{
+		Code:    core.TooSmall,
+		Params:  core.Params{"min": 5},
+		Message: "Too short",
+	})
+
+	assert.False(t, result.Ok)
+	assert.Equal(t, "Name", result.Path)
+	assert.Equal(t, []string{"Too short"}, result.Errors)
+	assert.Equal(t, []core.Issue{{
+		Code:     core.TooSmall,
+		Params:   core.Params{"min": 5},
+		Received: "string",
+		Message:  "Too short",
+	}}, result.Issues)
+}
+
+func TestNewTypeErrorResult(t *testing.T) {
+	schema := &core.Schema[string]{Path: "Name"}
+	result := schema.NewTypeErrorResult(nil, "string", "Must be a string.")
+
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Must be a string."}, result.Errors)
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+	assert.Equal(t, "string", result.Issues[0].Params["expected"])
+	assert.Equal(t, "nil", result.Issues[0].Received)
+}
+
+func TestAddIssues(t *testing.T) {
+	result := &core.Result[int]{Ok: true}
+	result.AddIssues()
+	assert.True(t, result.Ok)
+
+	result.AddIssues(core.Issue{Code: core.Custom, Path: core.Path{"a"}, Message: "b"})
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"a: b"}, result.Errors)
+	assert.Len(t, result.Issues, 1)
+}
+
+func TestParseGenericLegacyRule(t *testing.T) {
+	schema := &core.Schema[int]{Path: "test123"}
+	schema.AddRule(func(value int) *core.Result[int] {
+		return &core.Result[int]{Ok: false, Errors: []string{"hand-built"}}
+	})
+
+	result := schema.ParseGeneric(42)
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"hand-built"}, result.Errors)
+	assert.Equal(t, []core.Issue{{Code: core.Custom, Received: "int", Message: "hand-built"}}, result.Issues)
+}
+
+func TestPathString(t *testing.T) {
+	assert.Equal(t, "", core.Path{}.String())
+	assert.Equal(t, "Email", core.Path{"Email"}.String())
+	assert.Equal(t, "Applicants[3].Email", core.Path{"Applicants", 3, "Email"}.String())
+	assert.Equal(t, "[0][1]", core.Path{0, 1}.String())
+	assert.Equal(t, `Labels["app/name"].value`, core.Path{"Labels", "app/name", "value"}.String())
+}
+
+func TestPathPointer(t *testing.T) {
+	assert.Equal(t, "", core.Path{}.Pointer())
+	assert.Equal(t, "/applicants/3/email", core.Path{"applicants", 3, "email"}.Pointer())
+	assert.Equal(t, "/labels/app~1name/a~0b", core.Path{"labels", "app/name", "a~b"}.Pointer())
+}
+
+func TestErrorsByPointer(t *testing.T) {
+	result := &core.Result[int]{}
+	result.AddIssues(
+		core.Issue{Code: core.Custom, Path: core.Path{"applicants", 3, "email"}, Message: "a"},
+		core.Issue{Code: core.Custom, Path: core.Path{"applicants", 3, "email"}, Message: "b"},
+		core.Issue{Code: core.Custom, Message: "c"},
+	)
+
+	assert.Equal(t, map[string][]string{
+		"/applicants/3/email": {"a", "b"},
+		"":                    {"c"},
+	}, result.ErrorsByPointer())
+}
@@ -0,0 +1,387 @@
+package jsonschema
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document, ready to be encoded with encoding/json.
+type Schema map[string]interface{}
+
+var stringPatterns = map[string]string{
+	"time":   `^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`,
+	"nanoid": `^[a-zA-Z0-9_-]{21}$`,
+	"cuid":   `^c[0-9a-z]{24}$`,
+	"cuid2":  `^[a-z][a-z0-9]*$`,
+	"ulid":   `^[0-9A-HJKMNP-TV-Z]{26}$`,
+}
+
+var stringFormats = map[string]string{
+	"email": "email",
+	"url":   "uri",
+	"uuid":  "uuid",
+	"date":  "date",
+}
+
+// From converts a schema into a JSON Schema document. Checks without a JSON
+// Schema counterpart, such as CIDR notation or rules added through Refine,
+// are left out, so the document may accept more than the schema does.
+func From(schema interface{}) Schema {
+	return FromDescription(core.Describe(schema))
+}
+
+func FromDescription(description *core.Description) Schema {
+	e := NewExporter("#/$defs/")
+
+	document := Schema{"$schema": Draft}
+	for key, value := range e.ConvertDescription(description) {
+		document[key] = value
+	}
+	if definitions := e.Definitions(); len(definitions) > 0 {
+		document["$defs"] = definitions
+	}
+	return document
+}
+
+// Exporter converts descriptions into schemas that share definitions. Uses
+// of a schema named with Define, and lazy schemas that refer back to
+// themselves, become references to a single definition, while any other
+// lazy schema is inlined.
+type Exporter struct {
+	prefix      string
+	defs        *core.Definitions
+	pending     []definition
+	definitions map[string]Schema
+}
+
+type definition struct {
+	name        string
+	description *core.Description
+}
+
+// NewExporter returns an exporter whose references are the definition name
+// appended to prefix, such as "#/components/schemas/".
+func NewExporter(prefix string) *Exporter {
+	return &Exporter{
+		prefix:      prefix,
+		defs:        core.NewDefinitions(),
+		definitions: map[string]Schema{},
+	}
+}
+
+// Define names a schema, so that every use of it is converted into a
+// reference to its definition.
+func (e *Exporter) Define(name string, schema interface{}) {
+	description := core.Describe(schema)
+	e.defs.Define(name, description)
+	e.pending = append(e.pending, definition{name, description})
+}
+
+func (e *Exporter) Convert(schema interface{}) Schema {
+	return e.ConvertDescription(core.Describe(schema))
+}
+
+func (e *Exporter) ConvertDescription(description *core.Description) Schema {
+	e.defs.FindRecursive(description)
+	return e.convert(description)
+}
+
+// Definitions returns the definitions of the named schemas and of the
+// recursive schemas met so far, keyed by name.
+func (e *Exporter) Definitions() map[string]Schema {
+	for len(e.pending) > 0 {
+		next := e.pending[0]
+		e.pending = e.pending[1:]
+
+		description := next.description
+		e.defs.FindRecursive(description)
+		if description.Kind == core.KindLazy {
+			description = e.defs.Resolve(description)
+		}
+		e.definitions[next.name] = e.document(next.description, e.wrap(next.description, e.convertKind(description)))
+	}
+	return e.definitions
+}
+
+func (e *Exporter) convert(description *core.Description) Schema {
+	if name, isNamed := e.defs.Reference(description); isNamed {
+		return e.wrap(description, Schema{"$ref": e.prefix + name})
+	}
+	return e.document(description, e.wrap(description, e.convertKind(description)))
+}
+
+// wrap applies what nullable and default wrappers add to a description onto
+// its converted schema.
+func (e *Exporter) wrap(description *core.Description, schema Schema) Schema {
+	if description.Nullable {
+		schema = Schema{"anyOf": []interface{}{schema, Schema{"type": "null"}}}
+	}
+	if description.HasDefault {
+		schema["default"] = description.Default
+	}
+	return schema
+}
+
+// document adds the description and examples of a schema. References leave
+// them out, as the definition they refer to already has them.
+func (e *Exporter) document(description *core.Description, schema Schema) Schema {
+	if description.Doc != "" {
+		schema["description"] = description.Doc
+	}
+	if len(description.Examples) > 0 {
+		schema["examples"] = description.Examples
+	}
+	return schema
+}
+
+func (e *Exporter) convertKind(description *core.Description) Schema {
+	switch description.Kind {
+	case core.KindLazy:
+		resolved := e.defs.Resolve(description)
+		if !e.defs.IsRecursive(description) {
+			return e.convert(resolved)
+		}
+		name := e.defs.NameRecursive(description, func(name string) bool { return name != "" })
+		e.pending = append(e.pending, definition{name, resolved})
+		return Schema{"$ref": e.prefix + name}
+	case core.KindString:
+		return e.convertString(description)
+	case core.KindNumber, core.KindInteger:
+		return e.convertNumber(description)
+	case core.KindBoolean:
+		return Schema{"type": "boolean"}
+	case core.KindDate:
+		return Schema{"type": "string", "format": "date-time"}
+	case core.KindNil:
+		return Schema{"type": "null"}
+	case core.KindNever:
+		return Schema{"not": Schema{}}
+	case core.KindLiteral:
+		return Schema{"const": description.Values[0]}
+	case core.KindEnum:
+		// Enums built from struct tags keep the checks of the field type.
+		checks := e.convertString(description)
+		if len(description.Values) > 0 && reflect.TypeOf(description.Values[0]).Kind() != reflect.String {
+			checks = e.convertNumber(description)
+		}
+
+		schema := Schema{"enum": description.Values}
+		for key, value := range checks {
+			if key != "type" {
+				schema[key] = value
+			}
+		}
+		return schema
+	case core.KindArray, core.KindSet:
+		return e.convertArray(description)
+	case core.KindTuple:
+		return e.convertTuple(description)
+	case core.KindObject:
+		return e.convertObject(description)
+	case core.KindRecord:
+		return e.convertRecord(description)
+	case core.KindUnion:
+		keyword := "anyOf"
+		if description.Discriminator != "" {
+			keyword = "oneOf"
+		}
+		return Schema{keyword: e.convertAll(description.Options)}
+	case core.KindIntersection:
+		return Schema{"allOf": e.convertAll(description.Options)}
+	}
+	return Schema{}
+}
+
+func (e *Exporter) convertAll(descriptions []*core.Description) []interface{} {
+	schemas := make([]interface{}, len(descriptions))
+	for i, description := range descriptions {
+		schemas[i] = e.convert(description)
+	}
+	return schemas
+}
+
+func (e *Exporter) convertString(description *core.Description) Schema {
+	schema := Schema{"type": "string"}
+	var patterns []string
+
+	for _, check := range description.Checks {
+		switch check.Code {
+		case core.TooSmall:
+			schema["minLength"] = check.Params["min"]
+		case core.TooBig:
+			schema["maxLength"] = check.Params["max"]
+		case core.InvalidLength:
+			schema["minLength"] = check.Params["length"]
+			schema["maxLength"] = check.Params["length"]
+		case core.InvalidString:
+			validation, _ := check.Params["validation"].(string)
+			switch validation {
+			case "regex":
+				patterns = append(patterns, fmt.Sprint(check.Params["pattern"]))
+			case "includes":
+				patterns = append(patterns, regexp.QuoteMeta(fmt.Sprint(check.Params["includes"])))
+			case "starts_with":
+				patterns = append(patterns, "^"+regexp.QuoteMeta(fmt.Sprint(check.Params["starts_with"])))
+			case "ends_with":
+				patterns = append(patterns, regexp.QuoteMeta(fmt.Sprint(check.Params["ends_with"]))+"$")
+			case "ip":
+				schema["anyOf"] = []interface{}{Schema{"format": "ipv4"}, Schema{"format": "ipv6"}}
+			default:
+				if format, isFormat := stringFormats[validation]; isFormat {
+					schema["format"] = format
+				} else if pattern, isPattern := stringPatterns[validation]; isPattern {
+					patterns = append(patterns, pattern)
+				}
+			}
+		}
+	}
+
+	addPatterns(schema, patterns)
+	return schema
+}
+
+// addPatterns sets the pattern keyword, which holds a single expression, and
+// moves any further expressions into allOf.
+func addPatterns(schema Schema, patterns []string) {
+	if len(patterns) == 0 {
+		return
+	}
+
+	schema["pattern"] = patterns[0]
+	if len(patterns) == 1 {
+		return
+	}
+
+	var rest []interface{}
+	for _, pattern := range patterns[1:] {
+		rest = append(rest, Schema{"pattern": pattern})
+	}
+	schema["allOf"] = rest
+}
+
+func (e *Exporter) convertNumber(description *core.Description) Schema {
+	schema := Schema{"type": description.Kind}
+
+	for _, check := range description.Checks {
+		inclusive, _ := check.Params["inclusive"].(bool)
+		switch check.Code {
+		case core.TooSmall:
+			if inclusive {
+				schema["minimum"] = check.Params["min"]
+			} else {
+				schema["exclusiveMinimum"] = check.Params["min"]
+			}
+		case core.TooBig:
+			if inclusive {
+				schema["maximum"] = check.Params["max"]
+			} else {
+				schema["exclusiveMaximum"] = check.Params["max"]
+			}
+		case core.NotMultipleOf:
+			schema["multipleOf"] = check.Params["multiple_of"]
+		}
+	}
+
+	return schema
+}
+
+func (e *Exporter) convertArray(description *core.Description) Schema {
+	schema := Schema{"type": "array"}
+	if description.Element != nil {
+		schema["items"] = e.convert(description.Element)
+	}
+	if description.Kind == core.KindSet {
+		schema["uniqueItems"] = true
+	}
+
+	var contains []interface{}
+	for _, check := range description.Checks {
+		switch check.Code {
+		case core.TooSmall:
+			schema["minItems"] = check.Params["min"]
+		case core.TooBig:
+			schema["maxItems"] = check.Params["max"]
+		case core.InvalidLength:
+			schema["minItems"] = check.Params["length"]
+			schema["maxItems"] = check.Params["length"]
+		case core.NotUnique:
+			schema["uniqueItems"] = true
+		case core.MissingElement:
+			contains = append(contains, Schema{"contains": Schema{"const": check.Params["element"]}})
+		}
+	}
+
+	if len(contains) == 1 {
+		schema["contains"] = contains[0].(Schema)["contains"]
+	} else if len(contains) > 1 {
+		schema["allOf"] = contains
+	}
+	return schema
+}
+
+func (e *Exporter) convertTuple(description *core.Description) Schema {
+	schema := Schema{"type": "array", "prefixItems": e.convertAll(description.Items)}
+	if description.Rest != nil {
+		schema["items"] = e.convert(description.Rest)
+	} else {
+		schema["items"] = false
+		schema["minItems"] = len(description.Items)
+	}
+	return schema
+}
+
+func (e *Exporter) convertObject(description *core.Description) Schema {
+	properties := Schema{}
+	for key, field := range description.Fields {
+		properties[key] = e.convert(field)
+	}
+
+	schema := Schema{"type": "object", "properties": properties}
+	if len(description.Required) > 0 {
+		schema["required"] = description.Required
+	}
+	if !description.UnknownKeys {
+		schema["additionalProperties"] = false
+	}
+	return schema
+}
+
+func (e *Exporter) convertRecord(description *core.Description) Schema {
+	schema := Schema{"type": "object"}
+	if description.Element != nil {
+		schema["additionalProperties"] = e.convert(description.Element)
+	}
+	if description.Key != nil && isStringKind(description.Key.Kind) {
+		propertyNames := e.convert(description.Key)
+		delete(propertyNames, "type")
+		if len(propertyNames) > 0 {
+			schema["propertyNames"] = propertyNames
+		}
+	}
+	if len(description.Required) > 0 {
+		schema["required"] = description.Required
+	}
+
+	for _, check := range description.Checks {
+		switch check.Code {
+		case core.TooSmall:
+			schema["minProperties"] = check.Params["min"]
+		case core.TooBig:
+			schema["maxProperties"] = check.Params["max"]
+		}
+	}
+	return schema
+}
+
+func isStringKind(kind string) bool {
+	switch kind {
+	case core.KindString, core.KindEnum, core.KindLiteral:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/jsonschema"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertJSON compares documents by their encoding, so that expectations need
+// not repeat the Go types of bounds and params.
+func assertJSON(t *testing.T, expected string, schema jsonschema.Schema) {
+	t.Helper()
+	encoded, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, expected, string(encoded))
+}
+
+func TestFrom_String(t *testing.T) {
+	schema := primitives.NewStringSchema("username").Min(3).Max(20).Regex(regexp.MustCompile(`^[a-z]+$`)).StartsWith("a")
+	assertJSON(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "string",
+		"minLength": 3,
+		"maxLength": 20,
+		"pattern": "^[a-z]+$",
+		"allOf": [{"pattern": "^a"}]
+	}`, jsonschema.From(schema))
+
+	assertJSON(t, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "string", "format": "email"}`,
+		jsonschema.From(primitives.NewStringSchema("email").Email()))
+	assertJSON(t, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "string", "format": "uuid"}`,
+		jsonschema.From(primitives.NewStringSchema("id").UUID()))
+}
+
+func TestFrom_Number(t *testing.T) {
+	schema := primitives.NewNumberSchema[float64]("price").Gt(0).Lte(100).MultipleOf(0.5)
+	assertJSON(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "number",
+		"exclusiveMinimum": 0,
+		"maximum": 100,
+		"multipleOf": 0.5
+	}`, jsonschema.From(schema))
+
+	assertJSON(t, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "integer", "minimum": 0}`,
+		jsonschema.From(primitives.NewNumberSchema[int]("age").NonNegative()))
+}
+
+func TestFrom_Literals(t *testing.T) {
+	assertJSON(t, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "enum": ["draft", "published"]}`,
+		jsonschema.From(literals.NewEnumSchema("status", []string{"draft", "published"})))
+	assertJSON(t, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "const": 42}`,
+		jsonschema.From(literals.NewLiteralSchema("answer", 42)))
+}
+
+func TestFrom_Array(t *testing.T) {
+	schema := composites.NewArraySchema[string]("tags", primitives.NewStringSchema("tag").Min(1)).Min(1).Max(5).Unique()
+	assertJSON(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "array",
+		"items": {"type": "string", "minLength": 1},
+		"minItems": 1,
+		"maxItems": 5,
+		"uniqueItems": true
+	}`, jsonschema.From(schema))
+}
+
+func TestFrom_Object(t *testing.T) {
+	schema := composites.NewObjectSchema("user", composites.Shape{
+		"name":  primitives.NewStringSchema("name"),
+		"bio":   core.NewOptionalSchema[string](primitives.NewStringSchema("bio")),
+		"role":  core.NewDefaultSchema[string](primitives.NewStringSchema("role"), func() string { return "member" }),
+		"email": core.NewNullableSchema[string](primitives.NewStringSchema("email").Email()),
+	})
+	assertJSON(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"bio": {"type": "string"},
+			"role": {"type": "string", "default": "member"},
+			"email": {"anyOf": [{"type": "string", "format": "email"}, {"type": "null"}]}
+		},
+		"required": ["email", "name"],
+		"additionalProperties": false
+	}`, jsonschema.From(schema))
+}
+
+func TestFrom_Unions(t *testing.T) {
+	union := composites.NewUnionSchema[string]("id", primitives.NewStringSchema("id").UUID(), primitives.NewStringSchema("id").ULID())
+	assertJSON(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"anyOf": [{"type": "string", "format": "uuid"}, {"type": "string", "pattern": "^[0-9A-HJKMNP-TV-Z]{26}$"}]
+	}`, jsonschema.From(union))
+
+	shape := composites.NewDiscriminatedUnionSchema("shape", "kind", map[string]*composites.ObjectSchema{
+		"circle": composites.NewObjectSchema("circle", composites.Shape{
+			"kind":   literals.NewLiteralSchema("kind", "circle"),
+			"radius": primitives.NewNumberSchema[float64]("radius"),
+		}),
+	})
+	document := jsonschema.From(shape)
+	assert.Len(t, document["oneOf"], 1)
+}
+
+type category struct {
+	Name     string     `json:"name" v:"required,min=1"`
+	Parent   *category  `json:"parent"`
+	Children []category `json:"children"`
+}
+
+func TestFrom_Recursive(t *testing.T) {
+	assertJSON(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 1},
+			"parent": {"anyOf": [{"$ref": "#/$defs/category"}, {"type": "null"}]},
+			"children": {"type": "array", "items": {"$ref": "#/$defs/category"}}
+		},
+		"required": ["name", "children"],
+		"additionalProperties": false,
+		"$defs": {
+			"category": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "minLength": 1},
+					"parent": {"anyOf": [{"$ref": "#/$defs/category"}, {"type": "null"}]},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/category"}}
+				},
+				"required": ["name", "children"],
+				"additionalProperties": false
+			}
+		}
+	}`, jsonschema.From(composites.NewStructSchema[category]("category")))
+}
@@ -0,0 +1,709 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+)
+
+// KeywordError reports a keyword of a JSON Schema document that cannot be
+// enforced, such as a keyword the loader does not support or a $ref that
+// does not resolve.
+type KeywordError struct {
+	// Pointer locates the schema holding the keyword, e.g. "#/properties/tags".
+	Pointer string
+	Keyword string
+	Reason  string
+}
+
+func (e *KeywordError) Error() string {
+	return fmt.Sprintf("jsonschema: %s: %q %s", e.Pointer, e.Keyword, e.Reason)
+}
+
+// annotations are keywords that carry no assertion, so they are accepted
+// and otherwise ignored.
+var annotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"$anchor":     true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+	"deprecated":  true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+// Load builds a schema enforcing a JSON Schema document. Schemas apply to
+// values as decoded by encoding/json into interface{}, so numbers must be
+// float64 and objects map[string]interface{}. Every keyword the loader cannot
+// enforce is reported as a *KeywordError rather than skipped, and no schema
+// is returned in that case.
+func Load(document []byte) (core.Parser[interface{}], error) {
+	var root interface{}
+	if err := json.Unmarshal(document, &root); err != nil {
+		return nil, fmt.Errorf("jsonschema: %w", err)
+	}
+
+	l := &loader{root: root, refs: map[string]*core.LazySchema[interface{}]{}}
+	schema := l.ref("#", "#", "")
+	if len(l.errs) > 0 {
+		return nil, errors.Join(l.errs...)
+	}
+	return schema, nil
+}
+
+type loader struct {
+	root interface{}
+	refs map[string]*core.LazySchema[interface{}]
+	errs []error
+}
+
+func (l *loader) fail(pointer string, keyword string, reason string) {
+	l.errs = append(l.errs, &KeywordError{Pointer: pointer, Keyword: keyword, Reason: reason})
+}
+
+// keywords hands out the keywords of one schema object and remembers which
+// ones were used, so that the rest can be reported.
+type keywords struct {
+	node map[string]interface{}
+	used map[string]bool
+}
+
+func (k *keywords) take(keyword string) (interface{}, bool) {
+	value, exists := k.node[keyword]
+	if exists {
+		k.used[keyword] = true
+	}
+	return value, exists
+}
+
+func (k *keywords) has(names ...string) bool {
+	for _, name := range names {
+		if _, exists := k.node[name]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *loader) compile(node interface{}, pointer string, path string) core.Parser[interface{}] {
+	switch node := node.(type) {
+	case bool:
+		if node {
+			return untyped(primitives.NewAnySchema(path))
+		}
+		return untyped(primitives.NewNeverSchema(path))
+	case map[string]interface{}:
+		return l.compileObject(node, pointer, path)
+	}
+
+	l.fail(pointer, "", "must be an object or a boolean")
+	return untyped(primitives.NewAnySchema(path))
+}
+
+func (l *loader) compileObject(node map[string]interface{}, pointer string, path string) core.Parser[interface{}] {
+	k := &keywords{node: node, used: map[string]bool{}}
+	var parts []core.Parser[interface{}]
+
+	for _, container := range []string{"$defs", "definitions"} {
+		if defs, exists := k.take(container); exists {
+			definitions, isObject := defs.(map[string]interface{})
+			if !isObject {
+				l.fail(pointer, container, "must be an object")
+				continue
+			}
+			for _, name := range sortedNames(definitions) {
+				l.ref(pointer+"/"+container+"/"+escapePointer(name), pointer, name)
+			}
+		}
+	}
+
+	if ref, exists := k.take("$ref"); exists {
+		if ref, isString := ref.(string); isString {
+			parts = append(parts, l.ref(ref, pointer, path))
+		} else {
+			l.fail(pointer, "$ref", "must be a string")
+		}
+	}
+
+	if typed := l.compileTypes(k, pointer, path); typed != nil {
+		parts = append(parts, typed)
+	}
+
+	if enum, exists := k.take("enum"); exists {
+		values, isArray := enum.([]interface{})
+		if isArray && scalars(values) {
+			parts = append(parts, compileEnum(values, path))
+		} else {
+			l.fail(pointer, "enum", "must be an array of strings, numbers, booleans or nulls")
+		}
+	}
+
+	if constant, exists := k.take("const"); exists {
+		switch {
+		case constant == nil:
+			parts = append(parts, untyped(primitives.NewNilSchema(path)))
+		case scalars([]interface{}{constant}):
+			parts = append(parts, untyped(literals.NewLiteralSchema(path, constant)))
+		default:
+			l.fail(pointer, "const", "must be a string, number, boolean or null")
+		}
+	}
+
+	if options := l.compileAll(k, "anyOf", pointer, path); options != nil {
+		parts = append(parts, untyped(composites.NewUnionSchema(path, options...)))
+	}
+
+	if options := l.compileAll(k, "oneOf", pointer, path); options != nil {
+		union := composites.NewUnionSchema(path, options...).Refine(func(value interface{}) bool {
+			matches := 0
+			for _, option := range options {
+				if option.Parse(value).Ok {
+					matches++
+				}
+			}
+			return matches == 1
+		}, "Must match exactly one schema")
+		parts = append(parts, untyped(union))
+	}
+
+	if options := l.compileAll(k, "allOf", pointer, path); options != nil {
+		parts = append(parts, options...)
+	}
+
+	for _, keyword := range sortedNames(node) {
+		if !k.used[keyword] && !annotations[keyword] {
+			l.fail(pointer, keyword, "is not supported")
+		}
+	}
+
+	if len(parts) == 0 {
+		return untyped(primitives.NewAnySchema(path))
+	}
+
+	schema := parts[0]
+	for _, part := range parts[1:] {
+		schema = untyped(composites.NewIntersectionSchema(path, schema, part))
+	}
+	return schema
+}
+
+// compileEnum compiles an enum, where null is allowed through a nil schema
+// as enum schemas only match values of their own type.
+func compileEnum(values []interface{}, path string) core.Parser[interface{}] {
+	var allowed []interface{}
+	for _, value := range values {
+		if value != nil {
+			allowed = append(allowed, value)
+		}
+	}
+
+	enum := untyped(literals.NewEnumSchema(path, allowed))
+	if len(allowed) == len(values) {
+		return enum
+	}
+	return untyped(composites.NewUnionSchema(path, enum, untyped(primitives.NewNilSchema(path))))
+}
+
+func (l *loader) compileAll(k *keywords, keyword string, pointer string, path string) []core.Parser[interface{}] {
+	value, exists := k.take(keyword)
+	if !exists {
+		return nil
+	}
+
+	nodes, isArray := value.([]interface{})
+	if !isArray || len(nodes) == 0 {
+		l.fail(pointer, keyword, "must be a non-empty array")
+		return nil
+	}
+
+	options := make([]core.Parser[interface{}], len(nodes))
+	for i, node := range nodes {
+		options[i] = l.compile(node, fmt.Sprintf("%s/%s/%d", pointer, keyword, i), path)
+	}
+	return options
+}
+
+// ref returns the schema a reference points to. Every target is compiled
+// once and shared through a lazy schema, which lets schemas refer to
+// themselves.
+func (l *loader) ref(ref string, pointer string, path string) core.Parser[interface{}] {
+	if lazy, exists := l.refs[ref]; exists {
+		return lazy
+	}
+
+	target, found := l.resolve(ref)
+	if !found {
+		l.fail(pointer, "$ref", fmt.Sprintf("does not resolve: %s", ref))
+		return untyped(primitives.NewAnySchema(path))
+	}
+
+	var compiled core.Parser[interface{}]
+	lazy := core.NewLazySchema(func() core.Parser[interface{}] { return compiled })
+	l.refs[ref] = lazy
+	compiled = l.compile(target, ref, path)
+	return lazy
+}
+
+// resolve follows a JSON pointer into the document. References to other
+// documents are not supported.
+func (l *loader) resolve(ref string) (interface{}, bool) {
+	if ref != "#" && !strings.HasPrefix(ref, "#/") {
+		return nil, false
+	}
+
+	node := l.root
+	for _, segment := range strings.Split(ref, "/")[1:] {
+		segment = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+		switch parent := node.(type) {
+		case map[string]interface{}:
+			child, exists := parent[segment]
+			if !exists {
+				return nil, false
+			}
+			node = child
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(parent) {
+				return nil, false
+			}
+			node = parent[index]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// compileTypes compiles the type keyword. When the type is left out,
+// keywords such as minLength still apply to the values of their type, but
+// let values of any other type through.
+func (l *loader) compileTypes(k *keywords, pointer string, path string) core.Parser[interface{}] {
+	var types []string
+	if value, exists := k.take("type"); exists {
+		switch value := value.(type) {
+		case string:
+			types = []string{value}
+		case []interface{}:
+			for _, name := range value {
+				if name, isString := name.(string); isString {
+					types = append(types, name)
+				}
+			}
+		}
+		if len(types) == 0 {
+			l.fail(pointer, "type", "must be a string or an array of strings")
+			return nil
+		}
+	} else {
+		var schema core.Parser[interface{}]
+		for _, name := range keywordTypes(k) {
+			var part core.Parser[interface{}] = typeKeywords{name, l.compileType(k, name, pointer, path)}
+			if schema != nil {
+				part = untyped(composites.NewIntersectionSchema(path, schema, part))
+			}
+			schema = part
+		}
+		return schema
+	}
+
+	var options []core.Parser[interface{}]
+	nullable := false
+	for _, name := range types {
+		if name == "null" {
+			nullable = true
+			continue
+		}
+		if option := l.compileType(k, name, pointer, path); option != nil {
+			options = append(options, option)
+		}
+	}
+
+	var schema core.Parser[interface{}]
+	switch {
+	case len(options) == 1:
+		schema = options[0]
+	case len(options) > 1:
+		schema = untyped(composites.NewUnionSchema(path, options...))
+	case nullable:
+		return untyped(primitives.NewNilSchema(path))
+	default:
+		return nil
+	}
+
+	if nullable {
+		return untyped(composites.NewUnionSchema(path, schema, untyped(primitives.NewNilSchema(path))))
+	}
+	return schema
+}
+
+// keywordTypes returns the types constrained by the keywords of a schema.
+func keywordTypes(k *keywords) []string {
+	var types []string
+	if k.has("minLength", "maxLength", "pattern", "format") {
+		types = append(types, "string")
+	}
+	if k.has("minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf") {
+		types = append(types, "number")
+	}
+	if k.has("items", "minItems", "maxItems", "uniqueItems") {
+		types = append(types, "array")
+	}
+	if k.has("properties", "required", "additionalProperties", "minProperties", "maxProperties") {
+		types = append(types, "object")
+	}
+	return types
+}
+
+func (l *loader) compileType(k *keywords, name string, pointer string, path string) core.Parser[interface{}] {
+	switch name {
+	case "string":
+		return untyped(l.compileString(k, pointer, path))
+	case "number":
+		return untyped(l.compileNumber(k, pointer, path))
+	case "integer":
+		return untyped(l.compileNumber(k, pointer, path).Refine(func(value float64) bool {
+			return value == math.Trunc(value)
+		}, "Must be an integer", core.RefineOptions{Code: core.InvalidType, Params: core.Params{"expected": "integer"}}))
+	case "boolean":
+		return untyped(primitives.NewBooleanSchema(path))
+	case "array":
+		return l.compileArray(k, pointer, path)
+	case "object":
+		return l.compileProperties(k, pointer, path)
+	}
+
+	l.fail(pointer, "type", fmt.Sprintf("has unknown type %q", name))
+	return nil
+}
+
+func (l *loader) compileString(k *keywords, pointer string, path string) *primitives.StringSchema {
+	schema := primitives.NewStringSchema(path)
+
+	if minLength, exists := l.takeCount(k, "minLength", pointer); exists {
+		addLengthCheck(schema, core.TooSmall, core.Params{"type": "string", "min": minLength, "inclusive": true},
+			fmt.Sprintf("Must be at least %d characters long", minLength), func(length int) bool { return length >= minLength })
+	}
+	if maxLength, exists := l.takeCount(k, "maxLength", pointer); exists {
+		addLengthCheck(schema, core.TooBig, core.Params{"type": "string", "max": maxLength, "inclusive": true},
+			fmt.Sprintf("Must be at most %d characters long", maxLength), func(length int) bool { return length <= maxLength })
+	}
+	if pattern, exists := k.take("pattern"); exists {
+		source, _ := pattern.(string)
+		if regex, err := regexp.Compile(source); err == nil {
+			schema.Regex(regex)
+		} else {
+			l.fail(pointer, "pattern", "must be a regular expression supported by Go")
+		}
+	}
+	if format, exists := k.take("format"); exists {
+		l.compileFormat(schema, fmt.Sprint(format), pointer)
+	}
+
+	return schema
+}
+
+// addLengthCheck adds a length rule that counts code points like JSON Schema
+// does, whereas StringSchema.Min and Max count bytes.
+func addLengthCheck(schema *primitives.StringSchema, code string, params core.Params, message string, valid func(length int) bool) {
+	schema.Schema.AddCheck(core.Check{Code: code, Params: params}, func(value string) *core.Result[string] {
+		if !valid(utf8.RuneCountInString(value)) {
+			return schema.Schema.NewIssueResult(value, core.Issue{Code: code, Params: params, Message: message})
+		}
+		return schema.Schema.NewSuccessResult()
+	})
+}
+
+func (l *loader) compileFormat(schema *primitives.StringSchema, format string, pointer string) {
+	switch format {
+	case "email":
+		schema.Email()
+	case "uri":
+		schema.URL()
+	case "uuid":
+		schema.UUID()
+	case "date":
+		schema.Date()
+	case "time":
+		schema.Time()
+	case "date-time":
+		schema.Refine(func(value string) bool {
+			_, err := time.Parse(time.RFC3339, value)
+			return err == nil
+		}, "Must follow a valid date-time format", core.RefineOptions{
+			Code:   core.InvalidString,
+			Params: core.Params{"validation": "date_time"},
+		})
+	case "ipv4", "ipv6":
+		schema.IP().Refine(func(value string) bool {
+			return (net.ParseIP(value).To4() != nil) == (format == "ipv4")
+		}, "Must be a valid "+strings.ToUpper(format[:2])+format[2:]+" address", core.RefineOptions{
+			Code:   core.InvalidString,
+			Params: core.Params{"validation": format},
+		})
+	default:
+		l.fail(pointer, "format", fmt.Sprintf("has unsupported format %q", format))
+	}
+}
+
+func (l *loader) compileNumber(k *keywords, pointer string, path string) *primitives.NumberSchema[float64] {
+	schema := primitives.NewNumberSchema[float64](path)
+
+	bounds := []struct {
+		keyword string
+		apply   func(float64, ...string) *primitives.NumberSchema[float64]
+	}{
+		{"minimum", schema.Gte},
+		{"maximum", schema.Lte},
+		{"exclusiveMinimum", schema.Gt},
+		{"exclusiveMaximum", schema.Lt},
+		{"multipleOf", schema.MultipleOf},
+	}
+	for _, bound := range bounds {
+		value, exists := k.take(bound.keyword)
+		if !exists {
+			continue
+		}
+		if number, isNumber := value.(float64); isNumber {
+			bound.apply(number)
+		} else {
+			l.fail(pointer, bound.keyword, "must be a number")
+		}
+	}
+
+	return schema
+}
+
+func (l *loader) compileArray(k *keywords, pointer string, path string) core.Parser[interface{}] {
+	element := core.Parser[interface{}](untyped(primitives.NewAnySchema(path)))
+	if items, exists := k.take("items"); exists {
+		element = l.compile(items, pointer+"/items", path)
+	}
+
+	schema := composites.NewArraySchema(path, element)
+	if minItems, exists := l.takeCount(k, "minItems", pointer); exists {
+		schema.Min(minItems)
+	}
+	if maxItems, exists := l.takeCount(k, "maxItems", pointer); exists {
+		schema.Max(maxItems)
+	}
+	if unique, exists := k.take("uniqueItems"); exists && unique == true {
+		schema.Unique()
+	}
+
+	return untyped(schema)
+}
+
+// compileProperties compiles objects with properties into object schemas,
+// which let undeclared keys through unless additionalProperties is false,
+// and objects without properties into records.
+func (l *loader) compileProperties(k *keywords, pointer string, path string) core.Parser[interface{}] {
+	properties, hasProperties := k.take("properties")
+	additional, hasAdditional := k.take("additionalProperties")
+
+	required := map[string]bool{}
+	if value, exists := k.take("required"); exists {
+		names, isArray := value.([]interface{})
+		if !isArray {
+			l.fail(pointer, "required", "must be an array of strings")
+		}
+		for _, name := range names {
+			required[fmt.Sprint(name)] = true
+		}
+	}
+
+	if !hasProperties && len(required) == 0 && hasAdditional && additional != false {
+		values := l.compile(additional, pointer+"/additionalProperties", path)
+		schema := composites.NewRecordSchema[string, interface{}](path, primitives.NewStringSchema(path), values)
+		if minProperties, exists := l.takeCount(k, "minProperties", pointer); exists {
+			schema.MinSize(minProperties)
+		}
+		if maxProperties, exists := l.takeCount(k, "maxProperties", pointer); exists {
+			schema.MaxSize(maxProperties)
+		}
+		return untyped(schema)
+	}
+
+	fields, isObject := properties.(map[string]interface{})
+	if hasProperties && !isObject {
+		l.fail(pointer, "properties", "must be an object")
+	}
+
+	shape := composites.Shape{}
+	for _, name := range sortedNames(fields) {
+		field := l.compile(fields[name], pointer+"/properties/"+escapePointer(name), name)
+		if required[name] {
+			shape[name] = core.ToAnyParser(field)
+		} else {
+			shape[name] = core.NewOptionalSchema(field)
+		}
+	}
+	for name := range required {
+		if _, declared := shape[name]; !declared {
+			shape[name] = primitives.NewAnySchema(name)
+		}
+	}
+
+	schema := composites.NewObjectSchema(path, shape).Passthrough()
+	switch additional {
+	case nil, true:
+	case false:
+		schema.Strict()
+	default:
+		l.fail(pointer, "additionalProperties", "must be a boolean when properties are declared")
+	}
+	return untyped(schema)
+}
+
+func (l *loader) takeCount(k *keywords, keyword string, pointer string) (int, bool) {
+	value, exists := k.take(keyword)
+	if !exists {
+		return 0, false
+	}
+
+	count, isNumber := value.(float64)
+	if !isNumber || count < 0 || count != math.Trunc(count) {
+		l.fail(pointer, keyword, "must be a non-negative integer")
+		return 0, false
+	}
+	return int(count), true
+}
+
+// scalars reports whether every value can be compared with ==, which enum
+// and const schemas rely on.
+func scalars(values []interface{}) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case nil, string, float64, bool:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func sortedNames[V any](node map[string]V) []string {
+	names := make([]string, 0, len(node))
+	for name := range node {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func escapePointer(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
+
+// anySchema adapts a schema of any type to the interface{} values of a
+// decoded document, so that schemas of different types can be combined.
+type anySchema struct {
+	parser core.AnyParser
+}
+
+func untyped(parser core.AnyParser) core.Parser[interface{}] {
+	if typed, isTyped := parser.(core.Parser[interface{}]); isTyped {
+		return typed
+	}
+	return anySchema{parser}
+}
+
+func (s anySchema) Parse(value interface{}) *core.Result[interface{}] {
+	return s.ParseState(value, nil)
+}
+
+func (s anySchema) ParseState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return core.ParseAnyState(s.parser, value, state)
+}
+
+func (s anySchema) ParseTyped(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s anySchema) ParseTypedState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state)
+}
+
+func (s anySchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s anySchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state)
+}
+
+func (s anySchema) Describe() *core.Description {
+	return core.Describe(s.parser)
+}
+
+// typeKeywords applies the keywords of a type to the values of that type
+// only, for schemas that leave the type out.
+type typeKeywords struct {
+	name   string
+	parser core.Parser[interface{}]
+}
+
+func (s typeKeywords) Parse(value interface{}) *core.Result[interface{}] {
+	return s.ParseState(value, nil)
+}
+
+func (s typeKeywords) ParseState(value interface{}, state *core.State) *core.Result[interface{}] {
+	if !hasType(value, s.name) {
+		return &core.Result[interface{}]{Ok: true, Value: value}
+	}
+	return core.ParseState(s.parser, value, state)
+}
+
+func (s typeKeywords) ParseTyped(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s typeKeywords) ParseTypedState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state)
+}
+
+func (s typeKeywords) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s typeKeywords) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state)
+}
+
+// Describe describes the schema as any value, as descriptions have no way to
+// restrict keywords to a single type.
+func (s typeKeywords) Describe() *core.Description {
+	return &core.Description{Kind: core.KindAny, Path: core.Describe(s.parser).Path}
+}
+
+func hasType(value interface{}, name string) bool {
+	v := reflect.ValueOf(value)
+	switch name {
+	case "string":
+		return v.Kind() == reflect.String
+	case "number":
+		return v.CanInt() || v.CanUint() || v.CanFloat()
+	case "array":
+		return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+	case "object":
+		return v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/jsonschema"
+	"github.com/stretchr/testify/assert"
+)
+
+func decode(t *testing.T, document string) interface{} {
+	t.Helper()
+	var value interface{}
+	assert.NoError(t, json.Unmarshal([]byte(document), &value))
+	return value
+}
+
+func TestLoad(t *testing.T) {
+	schema, err := jsonschema.Load([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "Order",
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"email": {"type": "string", "format": "email"},
+			"status": {"enum": ["pending", "shipped"]},
+			"kind": {"const": "order"},
+			"quantity": {"type": "integer", "minimum": 1},
+			"note": {"type": ["string", "null"], "maxLength": 5},
+			"tags": {"type": "array", "items": {"type": "string", "minLength": 1, "pattern": "^[a-z]+$"}, "uniqueItems": true}
+		},
+		"required": ["id", "email", "status", "kind", "quantity"],
+		"additionalProperties": false
+	}`))
+	assert.NoError(t, err)
+
+	result := schema.Parse(decode(t, `{
+		"id": "123e4567-e89b-12d3-a456-426614174000",
+		"email": "abyan@example.com",
+		"status": "pending",
+		"kind": "order",
+		"quantity": 2,
+		"note": null,
+		"tags": ["fragile"]
+	}`))
+	assert.True(t, result.Ok, result.Errors)
+
+	result = schema.Parse(decode(t, `{
+		"id": "nope",
+		"email": "abyan@example.com",
+		"status": "lost",
+		"kind": "order",
+		"quantity": 1.5,
+		"tags": ["a", "a"],
+		"extra": true
+	}`))
+	assert.False(t, result.Ok)
+
+	var paths []string
+	for _, issue := range result.Issues {
+		paths = append(paths, issue.Path.String())
+	}
+	assert.ElementsMatch(t, []string{"id", "status", "quantity", "tags[1]", ""}, paths)
+}
+
+func TestLoad_Compositions(t *testing.T) {
+	schema, err := jsonschema.Load([]byte(`{
+		"$defs": {
+			"named": {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]},
+			"aged": {"type": "object", "properties": {"age": {"type": "number", "exclusiveMinimum": 0}}, "required": ["age"]}
+		},
+		"allOf": [{"$ref": "#/$defs/named"}, {"$ref": "#/$defs/aged"}]
+	}`))
+	assert.NoError(t, err)
+	assert.True(t, schema.Parse(decode(t, `{"name": "abyan", "age": 21}`)).Ok)
+	assert.False(t, schema.Parse(decode(t, `{"name": "abyan", "age": 0}`)).Ok)
+	assert.False(t, schema.Parse(decode(t, `{"age": 21}`)).Ok)
+
+	schema, err = jsonschema.Load([]byte(`{"oneOf": [{"type": "number", "multipleOf": 2}, {"type": "number", "multipleOf": 3}]}`))
+	assert.NoError(t, err)
+	assert.True(t, schema.Parse(4.0).Ok)
+	assert.True(t, schema.Parse(9.0).Ok)
+	assert.False(t, schema.Parse(6.0).Ok)
+	assert.False(t, schema.Parse(5.0).Ok)
+
+	schema, err = jsonschema.Load([]byte(`{"anyOf": [{"type": "string"}, {"type": "boolean"}]}`))
+	assert.NoError(t, err)
+	assert.True(t, schema.Parse(true).Ok)
+	assert.False(t, schema.Parse(1.0).Ok)
+
+	schema, err = jsonschema.Load([]byte(`{"enum": ["draft", 1, null]}`))
+	assert.NoError(t, err)
+	assert.True(t, schema.Parse(nil).Ok)
+	assert.True(t, schema.Parse(1.0).Ok)
+	assert.False(t, schema.Parse("published").Ok)
+	assert.False(t, schema.Parse([]interface{}{}).Ok)
+}
+
+func TestLoad_StringLength(t *testing.T) {
+	schema, err := jsonschema.Load([]byte(`{"type": "string", "minLength": 2, "maxLength": 2}`))
+	assert.NoError(t, err)
+	assert.True(t, schema.Parse("éé").Ok)
+	assert.True(t, schema.Parse("日本").Ok)
+	assert.False(t, schema.Parse("é").Ok)
+	assert.False(t, schema.Parse("abc").Ok)
+}
+
+func TestLoad_UntypedKeywords(t *testing.T) {
+	schema, err := jsonschema.Load([]byte(`{"minLength": 2}`))
+	assert.NoError(t, err)
+	assert.True(t, schema.Parse("ab").Ok)
+	assert.False(t, schema.Parse("a").Ok)
+	assert.True(t, schema.Parse(5.0).Ok)
+	assert.True(t, schema.Parse(nil).Ok)
+
+	schema, err = jsonschema.Load([]byte(`{"minimum": 1, "required": ["id"]}`))
+	assert.NoError(t, err)
+	assert.True(t, schema.Parse(2.0).Ok)
+	assert.False(t, schema.Parse(0.0).Ok)
+	assert.True(t, schema.Parse(decode(t, `{"id": 1}`)).Ok)
+	assert.False(t, schema.Parse(decode(t, `{}`)).Ok)
+	assert.True(t, schema.Parse("text").Ok)
+}
+
+func TestLoad_Recursive(t *testing.T) {
+	schema, err := jsonschema.Load([]byte(`{
+		"$ref": "#/$defs/node",
+		"$defs": {
+			"node": {
+				"type": "object",
+				"properties": {
+					"value": {"type": "number"},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/node"}}
+				},
+				"required": ["value"]
+			}
+		}
+	}`))
+	assert.NoError(t, err)
+
+	assert.True(t, schema.Parse(decode(t, `{"value": 1, "children": [{"value": 2, "children": []}]}`)).Ok)
+
+	result := schema.Parse(decode(t, `{"value": 1, "children": [{"value": "two"}]}`))
+	assert.False(t, result.Ok)
+	assert.Equal(t, "children[0].value", result.Issues[0].Path.String())
+}
+
+func TestLoad_Unsupported(t *testing.T) {
+	schema, err := jsonschema.Load([]byte(`{
+		"type": "object",
+		"properties": {
+			"host": {"type": "string", "format": "hostname"},
+			"parent": {"$ref": "https://example.com/parent.json"},
+			"flags": {"type": "array", "contains": {"const": "admin"}}
+		},
+		"patternProperties": {"^x-": {}}
+	}`))
+	assert.Nil(t, schema)
+
+	var keywordErr *jsonschema.KeywordError
+	assert.True(t, errors.As(err, &keywordErr))
+
+	var reported []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		keywordErr := err.(*jsonschema.KeywordError)
+		reported = append(reported, keywordErr.Pointer+" "+keywordErr.Keyword)
+	}
+	assert.ElementsMatch(t, []string{
+		"# patternProperties",
+		"#/properties/flags contains",
+		"#/properties/host format",
+		"#/properties/parent $ref",
+	}, reported)
+}
+
+func TestLoad_RoundTrip(t *testing.T) {
+	document := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "maxLength": 20},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 3}
+		},
+		"required": ["name"],
+		"additionalProperties": false
+	}`
+	schema, err := jsonschema.Load([]byte(document))
+	assert.NoError(t, err)
+	assertJSON(t, document, jsonschema.From(schema))
+
+	assert.Equal(t, core.KindLazy, core.Describe(schema).Kind)
+}
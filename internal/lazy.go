@@ -0,0 +1,116 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const DefaultMaxDepth = 256
+
+// LazySchema resolves its schema on first use, so that a schema can refer to
+// itself. Every nested parse through a lazy schema counts as one level of
+// depth, and values that contain themselves are rejected instead of looping.
+type LazySchema[T any] struct {
+	Resolve  func() Parser[T]
+	MaxDepth int
+
+	once   sync.Once
+	schema Parser[T]
+}
+
+func NewLazySchema[T any](resolve func() Parser[T]) *LazySchema[T] {
+	return &LazySchema[T]{Resolve: resolve, MaxDepth: DefaultMaxDepth}
+}
+
+func (s *LazySchema[T]) Parse(value interface{}) *Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *LazySchema[T]) ParseContext(ctx context.Context, value interface{}) *Result[T] {
+	return ParseContext[T](ctx, s, value)
+}
+
+func (s *LazySchema[T]) ParseState(value interface{}, state *State) *Result[T] {
+	entered, failedResult := s.enter(value, state)
+	if failedResult != nil {
+		return failedResult
+	}
+
+	return ParseState(s.resolve(), value, entered)
+}
+
+func (s *LazySchema[T]) ParseTyped(value T) *Result[T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *LazySchema[T]) ParseTypedState(value T, state *State) *Result[T] {
+	entered, failedResult := s.enter(value, state)
+	if failedResult != nil {
+		return failedResult
+	}
+
+	return ParseTypedState(s.resolve(), value, entered)
+}
+
+// Resolved returns the schema the lazy schema resolves to.
+func (s *LazySchema[T]) Resolved() Parser[T] {
+	return s.resolve()
+}
+
+// enter derives the state of the value, or returns the failed result of a
+// value that contains itself or is nested too deep.
+func (s *LazySchema[T]) enter(value interface{}, state *State) (*State, *Result[T]) {
+	entered, acyclic := state.EnterFrom(s, value)
+	if !acyclic {
+		result := &Result[T]{}
+		result.AddIssues(Issue{Code: Cycle, Received: TypeName(value), Message: "Value must not contain itself"})
+		return nil, result
+	}
+	if entered.Depth() > s.MaxDepth {
+		result := &Result[T]{}
+		result.AddIssues(Issue{
+			Code:     TooDeep,
+			Params:   Params{"max_depth": s.MaxDepth},
+			Received: TypeName(value),
+			Message:  fmt.Sprintf("Must not be nested more than %d levels deep", s.MaxDepth),
+		})
+		return nil, result
+	}
+
+	return entered, nil
+}
+
+func (s *LazySchema[T]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *LazySchema[T]) Describe() *Description {
+	return &Description{
+		Kind: KindLazy,
+		Ref:  s,
+		Resolve: func() *Description {
+			return Describe(s.resolve())
+		},
+	}
+}
+
+func (s *LazySchema[T]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *LazySchema[T]) ParseAbsent(state *State) (*Result[interface{}], bool) {
+	return ParseAbsent(s.resolve(), state)
+}
+
+func (s *LazySchema[T]) WithMaxDepth(maxDepth int) *LazySchema[T] {
+	s.MaxDepth = maxDepth
+	return s
+}
+
+func (s *LazySchema[T]) resolve() Parser[T] {
+	s.once.Do(func() {
+		s.schema = s.Resolve()
+	})
+	return s.schema
+}
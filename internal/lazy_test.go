@@ -0,0 +1,165 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCategorySchema() (*composites.ObjectSchema, *core.LazySchema[map[string]interface{}]) {
+	var category *composites.ObjectSchema
+	lazyCategory := core.NewLazySchema(func() core.Parser[map[string]interface{}] {
+		return category
+	})
+	category = composites.NewObjectSchema("Category", composites.Shape{
+		"name":     primitives.NewStringSchema("Name").Min(1),
+		"children": composites.NewArraySchema[map[string]interface{}]("Children", lazyCategory),
+	})
+	return category, lazyCategory
+}
+
+func TestLazySchema(t *testing.T) {
+	category, _ := newCategorySchema()
+
+	result := category.Parse(map[string]interface{}{
+		"name": "Root",
+		"children": []interface{}{
+			map[string]interface{}{"name": "Leaf", "children": []interface{}{}},
+			map[string]interface{}{
+				"name": "Branch",
+				"children": []interface{}{
+					map[string]interface{}{"name": "", "children": []interface{}{}},
+				},
+			},
+		},
+	})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, "children[1].children[0].name", result.Issues[0].Path.String())
+}
+
+func TestLazySchema_MaxDepth(t *testing.T) {
+	category, lazyCategory := newCategorySchema()
+	lazyCategory.WithMaxDepth(2)
+
+	nest := func(depth int) map[string]interface{} {
+		node := map[string]interface{}{"name": "Leaf", "children": []interface{}{}}
+		for i := 0; i < depth; i++ {
+			node = map[string]interface{}{"name": "Node", "children": []interface{}{node}}
+		}
+		return node
+	}
+
+	assert.True(t, category.Parse(nest(2)).Ok)
+
+	result := category.Parse(nest(3))
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.TooDeep, result.Issues[0].Code)
+	assert.Equal(t, "children[0].children[0].children[0]", result.Issues[0].Path.String())
+	assert.Equal(t, core.Params{"max_depth": 2}, result.Issues[0].Params)
+}
+
+func TestLazySchema_Cycle(t *testing.T) {
+	category, _ := newCategorySchema()
+
+	root := map[string]interface{}{"name": "Root"}
+	child := map[string]interface{}{"name": "Child", "children": []interface{}{root}}
+	root["children"] = []interface{}{child}
+
+	result := category.Parse(root)
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.Cycle, result.Issues[0].Code)
+	assert.Equal(t, "children[0].children[0].children[0]", result.Issues[0].Path.String())
+}
+
+func TestLazySchema_Chained(t *testing.T) {
+	category, lazyCategory := newCategorySchema()
+	alias := core.NewLazySchema(func() core.Parser[map[string]interface{}] { return lazyCategory })
+
+	assert.True(t, alias.Parse(map[string]interface{}{"name": "Root", "children": []interface{}{}}).Ok)
+
+	root := map[string]interface{}{"name": "Root"}
+	root["children"] = []interface{}{root}
+	result := alias.Parse(root)
+	assert.Equal(t, core.Cycle, result.Issues[0].Code)
+	assert.False(t, category.Parse(root).Ok)
+}
+
+func TestLazySchema_Wrapped(t *testing.T) {
+	var comment *composites.ObjectSchema
+	comment = composites.NewObjectSchema("Comment", composites.Shape{
+		"body": primitives.NewStringSchema("Body"),
+		"reply": core.NewOptionalSchema[map[string]interface{}](core.NewLazySchema(func() core.Parser[map[string]interface{}] {
+			return comment
+		})),
+	})
+
+	assert.True(t, comment.Parse(map[string]interface{}{"body": "a", "reply": map[string]interface{}{"body": "b"}}).Ok)
+
+	result := comment.Parse(map[string]interface{}{"body": "a", "reply": map[string]interface{}{"body": 1}})
+	assert.Equal(t, []string{"reply: body: Must be a string."}, result.Errors)
+}
+
+func TestState_Enter(t *testing.T) {
+	var state *core.State
+	assert.Equal(t, 0, state.Depth())
+
+	shared := []int{1, 2, 3}
+	state, acyclic := state.Enter(shared)
+	assert.True(t, acyclic)
+	assert.Equal(t, 1, state.Depth())
+
+	_, acyclic = state.Enter(shared[:2])
+	assert.True(t, acyclic)
+
+	_, acyclic = state.Enter(shared)
+	assert.False(t, acyclic)
+
+	_, acyclic = state.Enter("scalar")
+	assert.True(t, acyclic)
+}
+
+type treeNode struct {
+	Name     string
+	Children []treeNode
+}
+
+type listNode struct {
+	Next *listNode
+}
+
+func TestLazySchema_ParseTyped(t *testing.T) {
+	var node *composites.StructOfSchema[treeNode]
+	lazyNode := core.NewLazySchema(func() core.Parser[treeNode] { return node })
+	node = composites.NewStructOfSchema("Node", func(b *composites.Fields[treeNode], x *treeNode) {
+		composites.Field(b, "Name", &x.Name, primitives.NewStringSchema("Name"))
+		composites.Field(b, "Children", &x.Children, composites.NewArraySchema[treeNode]("Children", lazyNode))
+	})
+
+	tree := treeNode{Name: "Leaf"}
+	for i := 0; i < 1000; i++ {
+		tree = treeNode{Name: "Node", Children: []treeNode{tree}}
+	}
+
+	result := node.ParseTyped(tree)
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.TooDeep, result.Issues[0].Code)
+
+	var list *core.LazySchema[*listNode]
+	list = core.NewLazySchema(func() core.Parser[*listNode] {
+		return core.NewNullableSchema[listNode](composites.NewStructOfSchema("List", func(b *composites.Fields[listNode], x *listNode) {
+			composites.Field(b, "Next", &x.Next, list)
+		}))
+	})
+
+	head := &listNode{}
+	head.Next = &listNode{Next: head}
+
+	cycleResult := list.ParseTyped(head)
+	assert.False(t, cycleResult.Ok)
+	assert.Equal(t, core.Cycle, cycleResult.Issues[0].Code)
+	assert.Equal(t, "Next.Next", cycleResult.Issues[0].Path.String())
+}
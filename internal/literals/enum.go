@@ -1,10 +1,16 @@
 package literals
 
-import core "github.com/abyanmajid/v/internal"
+import (
+	"context"
+	"reflect"
+
+	core "github.com/abyanmajid/v/internal"
+)
 
 type EnumSchema[T comparable] struct {
 	Schema *core.Schema[T]
 	Enums  map[T]struct{}
+	Values []T
 }
 
 func NewEnumSchema[T comparable](path string, allowedValues []T) *EnumSchema[T] {
@@ -18,19 +24,123 @@ func NewEnumSchema[T comparable](path string, allowedValues []T) *EnumSchema[T]
 			Path:  path,
 			Rules: []core.Rule[T]{},
 		},
-		Enums: enumMap,
+		Enums:  enumMap,
+		Values: allowedValues,
 	}
 }
 
 func (s *EnumSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *EnumSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, s, value)
+}
+
+func (s *EnumSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
 	typedValue, ok := value.(T)
 	if !ok {
-		return s.Schema.NewErrorResult("Invalid type.")
+		return s.Schema.NewTypeErrorResult(value, core.TypeName(*new(T)), "Invalid type.")
+	}
+
+	return s.parseTyped(typedValue, state)
+}
+
+func (s *EnumSchema[T]) ParseTyped(value T) *core.Result[T] {
+	return s.parseTyped(value, nil)
+}
+
+func (s *EnumSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	return s.parseTyped(value, state)
+}
+
+func (s *EnumSchema[T]) parseTyped(value T, state *core.State) *core.Result[T] {
+	if !s.contains(value) {
+		return s.Schema.NewIssueResult(value, core.Issue{
+			Code:    core.NotInEnum,
+			Params:  core.Params{"allowed": s.Values},
+			Message: "Value is not in the allowed enum set.",
+		})
 	}
 
-	if _, exists := s.Enums[typedValue]; !exists {
-		return s.Schema.NewErrorResult("Value is not in the allowed enum set.")
+	return s.Schema.ParseGenericState(value, state)
+}
+
+// contains looks the value up in the allowed set. Enums of interface types
+// may be handed maps and slices, which cannot be looked up and are never
+// allowed.
+func (s *EnumSchema[T]) contains(value T) bool {
+	if valueType := reflect.TypeOf(value); valueType != nil && !valueType.Comparable() {
+		return false
 	}
+	_, exists := s.Enums[value]
+	return exists
+}
+
+func (s *EnumSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *EnumSchema[T]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindEnum)
+	for _, value := range s.Values {
+		description.Values = append(description.Values, value)
+	}
+	return description
+}
+
+func (s *EnumSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *EnumSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, func() T { return value })
+}
+
+func (s *EnumSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, defaultValue)
+}
+
+func (s *EnumSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](s, fallback)
+}
+
+func (s *EnumSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *EnumSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *EnumSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *EnumSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *EnumSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *EnumSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *EnumSchema[T]) Messages(messageFunc core.MessageFunc) *EnumSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *EnumSchema[T]) FailFast() *EnumSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *EnumSchema[T]) Description(text string) *EnumSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *EnumSchema[T]) Example(value T) *EnumSchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
 
-	return s.Schema.ParseGeneric(typedValue)
+func (s *EnumSchema[T]) Then() *EnumSchema[T] {
+	s.Schema.Then()
+	return s
 }
@@ -3,6 +3,7 @@ package literals
 import (
 	"testing"
 
+	core "github.com/abyanmajid/v/internal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,3 +42,38 @@ func TestEnumSchema_Parse(t *testing.T) {
 		}
 	}
 }
+
+func TestEnumSchema_ParseTyped(t *testing.T) {
+	enumSchema := NewEnumSchema("abyan has a majestic cat", []int{1, 2, 3})
+
+	result := enumSchema.ParseTyped(2)
+	assert.True(t, result.Ok)
+	assert.Equal(t, 2, result.Value)
+
+	result = enumSchema.ParseTyped(4)
+	assert.False(t, result.Ok)
+	assert.Contains(t, result.Errors, "Value is not in the allowed enum set.")
+}
+
+func TestEnumSchema_Issues(t *testing.T) {
+	enumSchema := NewEnumSchema("abyan has a majestic cat", []string{"value1", "value2"})
+
+	result := enumSchema.Parse("value3")
+	assert.Equal(t, core.NotInEnum, result.Issues[0].Code)
+	assert.Equal(t, []string{"value1", "value2"}, result.Issues[0].Params["allowed"])
+
+	result = enumSchema.Parse(3)
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+	assert.Equal(t, "string", result.Issues[0].Params["expected"])
+	assert.Equal(t, "int", result.Issues[0].Received)
+}
+
+func TestEnumSchema_Interface(t *testing.T) {
+	enumSchema := NewEnumSchema[interface{}]("value", []interface{}{"a", 1.0})
+
+	assert.True(t, enumSchema.Parse(1.0).Ok)
+	assert.False(t, enumSchema.Parse(1).Ok)
+
+	result := enumSchema.Parse(map[string]interface{}{"a": 1})
+	assert.Equal(t, core.NotInEnum, result.Issues[0].Code)
+}
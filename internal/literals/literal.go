@@ -1,6 +1,7 @@
 package literals
 
 import (
+	"context"
 	"fmt"
 
 	core "github.com/abyanmajid/v/internal"
@@ -22,14 +23,104 @@ func NewLiteralSchema[T comparable](path string, literalValue T) *LiteralSchema[
 }
 
 func (s *LiteralSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *LiteralSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, s, value)
+}
+
+func (s *LiteralSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
 	typedValue, ok := value.(T)
 	if !ok {
-		return s.Schema.NewErrorResult("Invalid type.")
+		return s.Schema.NewTypeErrorResult(value, core.TypeName(s.Value), "Invalid type.")
 	}
 
-	if typedValue != s.Value {
-		return s.Schema.NewErrorResult(fmt.Sprintf("Value must be %v.", s.Value))
+	return s.parseTyped(typedValue, state)
+}
+
+func (s *LiteralSchema[T]) ParseTyped(value T) *core.Result[T] {
+	return s.parseTyped(value, nil)
+}
+
+func (s *LiteralSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	return s.parseTyped(value, state)
+}
+
+func (s *LiteralSchema[T]) parseTyped(value T, state *core.State) *core.Result[T] {
+	if value != s.Value {
+		return s.Schema.NewIssueResult(value, core.Issue{
+			Code:    core.InvalidLiteral,
+			Params:  core.Params{"expected": s.Value},
+			Message: fmt.Sprintf("Value must be %v.", s.Value),
+		})
 	}
 
-	return s.Schema.ParseGeneric(typedValue)
+	return s.Schema.ParseGenericState(value, state)
+}
+
+func (s *LiteralSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *LiteralSchema[T]) Describe() *core.Description {
+	description := s.Schema.Describe(core.KindLiteral)
+	description.Values = []interface{}{s.Value}
+	return description
+}
+
+func (s *LiteralSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *LiteralSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, func() T { return value })
+}
+
+func (s *LiteralSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, defaultValue)
+}
+
+func (s *LiteralSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](s, fallback)
+}
+
+func (s *LiteralSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *LiteralSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *LiteralSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *LiteralSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *LiteralSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *LiteralSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *LiteralSchema[T]) Messages(messageFunc core.MessageFunc) *LiteralSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *LiteralSchema[T]) FailFast() *LiteralSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *LiteralSchema[T]) Description(text string) *LiteralSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *LiteralSchema[T]) Example(value T) *LiteralSchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *LiteralSchema[T]) Then() *LiteralSchema[T] {
+	s.Schema.Then()
+	return s
 }
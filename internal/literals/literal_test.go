@@ -3,6 +3,7 @@ package literals
 import (
 	"testing"
 
+	core "github.com/abyanmajid/v/internal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,3 +37,23 @@ func TestLiteralSchema_Parse(t *testing.T) {
 		assert.Equal(t, "Value must be testValue.", result.Errors[0])
 	})
 }
+
+func TestLiteralSchema_ParseTyped(t *testing.T) {
+	schema := NewLiteralSchema("test/path", 42)
+
+	result := schema.ParseTyped(42)
+	assert.True(t, result.Ok)
+	assert.Equal(t, 42, result.Value)
+
+	result = schema.ParseTyped(7)
+	assert.False(t, result.Ok)
+	assert.Equal(t, "Value must be 42.", result.Errors[0])
+}
+
+func TestLiteralSchema_Issues(t *testing.T) {
+	schema := NewLiteralSchema("test/path", "testValue")
+
+	result := schema.Parse("wrongValue")
+	assert.Equal(t, core.InvalidLiteral, result.Issues[0].Code)
+	assert.Equal(t, "testValue", result.Issues[0].Params["expected"])
+}
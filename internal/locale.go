@@ -0,0 +1,311 @@
+package core
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const DefaultLocale = "en"
+
+// Catalog holds the messages of one locale, keyed by issue code. A key can be
+// narrowed down by the "validation", "type" or "expected" param of the issue,
+// by ".exclusive" for exclusive bounds and by a plural category, so
+// "too_small.array.one" wins over "too_small.array", which wins over
+// "too_small". The "index" key renders the array index segments of Errors.
+type Catalog struct {
+	Messages map[string]string
+	Plural   func(count float64) string
+}
+
+// PluralOneOther implements the plural rule of languages like English, which
+// only distinguish "one" from "other".
+func PluralOneOther(count float64) string {
+	if count == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+// PluralOther implements the plural rule of languages without plural forms,
+// like Indonesian and Japanese.
+func PluralOther(count float64) string {
+	return "other"
+}
+
+var English = &Catalog{
+	Plural: PluralOneOther,
+	Messages: map[string]string{
+		"index": "Element at index {index}",
+
+		InvalidType:                    "Must be of type {expected}",
+		InvalidType + ".nil":           "Value must be nil",
+		InvalidDate:                    "Must be a valid date in {format} format",
+		InvalidString:                  "Must be a valid string",
+		InvalidString + ".email":       "Must be a valid email address",
+		InvalidString + ".url":         "Must be a valid URL",
+		InvalidString + ".regex":       "Must match the required pattern",
+		InvalidString + ".includes":    "Must include '{includes}'",
+		InvalidString + ".starts_with": "Must start with '{starts_with}'",
+		InvalidString + ".ends_with":   "Must end with '{ends_with}'",
+		InvalidString + ".date":        "Must follow a valid date format",
+		InvalidString + ".time":        "Must follow a valid time format",
+		InvalidString + ".ip":          "Must be a valid IP address",
+		InvalidString + ".cidr":        "Must be of valid CIDR notation",
+		InvalidString + ".uuid":        "Must be a valid UUID",
+		InvalidString + ".nanoid":      "Must be a valid NanoID",
+		InvalidString + ".cuid":        "Must be a valid CUID",
+		InvalidString + ".cuid2":       "Must be a valid CUID2",
+		InvalidString + ".ulid":        "Must be a valid ULID",
+
+		InvalidLength:                   "Must have a length of exactly {length}",
+		InvalidLength + ".string.one":   "Must be exactly {length} character long",
+		InvalidLength + ".string.other": "Must be exactly {length} characters long",
+		InvalidLength + ".array.one":    "Array must have exactly {length} element",
+		InvalidLength + ".array.other":  "Array must have exactly {length} elements",
+
+		TooSmall:                       "Must be at least {min}",
+		TooSmall + ".exclusive":        "Must be greater than {min}",
+		TooSmall + ".number":           "Must be greater than or equal to {min}",
+		TooSmall + ".number.exclusive": "Must be greater than {min}",
+		TooSmall + ".date":             "Must be later than or equal to {min}",
+		TooSmall + ".string.one":       "Must be at least {min} character long",
+		TooSmall + ".string.other":     "Must be at least {min} characters long",
+		TooSmall + ".array.one":        "Array must have at least {min} element",
+		TooSmall + ".array.other":      "Array must have at least {min} elements",
+		TooSmall + ".record.one":       "Must have at least {min} entry",
+		TooSmall + ".record.other":     "Must have at least {min} entries",
+		TooSmall + ".set.one":          "Set must have at least {min} element",
+		TooSmall + ".set.other":        "Set must have at least {min} elements",
+
+		TooBig:                       "Must be at most {max}",
+		TooBig + ".exclusive":        "Must be smaller than {max}",
+		TooBig + ".number":           "Must be smaller than or equal to {max}",
+		TooBig + ".number.exclusive": "Must be smaller than {max}",
+		TooBig + ".date":             "Must be earlier than or equal to {max}",
+		TooBig + ".string.one":       "Must be at most {max} character long",
+		TooBig + ".string.other":     "Must be at most {max} characters long",
+		TooBig + ".array.one":        "Array must have at most {max} element",
+		TooBig + ".array.other":      "Array must have at most {max} elements",
+		TooBig + ".record.one":       "Must have at most {max} entry",
+		TooBig + ".record.other":     "Must have at most {max} entries",
+		TooBig + ".set.one":          "Set must have at most {max} element",
+		TooBig + ".set.other":        "Set must have at most {max} elements",
+
+		InvalidLiteral:  "Value must be {expected}",
+		NotMultipleOf:   "Must be a multiple of {multiple_of}",
+		NotFinite:       "Must be a finite number",
+		NotInEnum:       "Must be one of {allowed}",
+		NotAllowed:      "Value is not allowed",
+		Required:        "Is required",
+		UnrecognizedKey: "Unrecognized key '{key}'",
+
+		InvalidDiscriminator: "Unknown discriminator, expected one of {allowed}",
+		InvalidIntersection:  "Intersection results could not be merged",
+		NotUnique:            "Duplicate of element at index {duplicate_of}",
+		MissingElement:       "Must contain {element}",
+		TooDeep:              "Must not be nested more than {max_depth} levels deep",
+		Cycle:                "Value must not contain itself",
+		Canceled:             "Validation did not finish: {reason}",
+		NotFound:             "Does not exist",
+		AlreadyExists:        "Already exists",
+	},
+}
+
+var (
+	catalogsMu sync.RWMutex
+	catalogs   = map[string]*Catalog{DefaultLocale: English}
+)
+
+// RegisterCatalog makes a catalog available under a locale such as "id" or
+// "pt-BR". Registering a locale twice replaces the previous catalog.
+func RegisterCatalog(locale string, catalog *Catalog) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	catalogs[normalizeLocale(locale)] = catalog
+}
+
+// LookupCatalog finds the catalog of a locale, falling back from a regional
+// locale such as "id-ID" to its language "id".
+func LookupCatalog(locale string) (*Catalog, bool) {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+
+	locale = normalizeLocale(locale)
+	if catalog, ok := catalogs[locale]; ok {
+		return catalog, true
+	}
+	if language, _, found := strings.Cut(locale, "-"); found {
+		catalog, ok := catalogs[language]
+		return catalog, ok
+	}
+	return nil, false
+}
+
+// NegotiateLocale picks the registered locale that best matches an HTTP
+// Accept-Language header, or DefaultLocale when none does.
+func NegotiateLocale(acceptLanguage string) string {
+	type preference struct {
+		locale  string
+		quality float64
+	}
+
+	var preferences []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		locale, qualityParam, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(qualityParam), "q="); found {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if locale == "" || locale == "*" || quality <= 0 {
+			continue
+		}
+		preferences = append(preferences, preference{locale, quality})
+	}
+
+	sort.SliceStable(preferences, func(i, j int) bool {
+		return preferences[i].quality > preferences[j].quality
+	})
+
+	for _, preference := range preferences {
+		if _, ok := LookupCatalog(preference.locale); ok {
+			return normalizeLocale(preference.locale)
+		}
+	}
+	return DefaultLocale
+}
+
+// Message translates an issue, reporting false when the catalog has no
+// message for its code.
+func (c *Catalog) Message(issue Issue) (string, bool) {
+	keys := []string{issue.Code}
+	for _, param := range []string{"validation", "type", "expected"} {
+		if sub, ok := issue.Params[param].(string); ok {
+			keys = append(keys, keys[len(keys)-1]+"."+sub)
+			break
+		}
+	}
+	if inclusive, ok := issue.Params["inclusive"].(bool); ok && !inclusive {
+		keys = append(keys, keys[len(keys)-1]+".exclusive")
+	}
+
+	category := ""
+	if count, ok := pluralCount(issue.Params); ok {
+		category = "other"
+		if c.Plural != nil {
+			category = c.Plural(count)
+		}
+	}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		if category != "" {
+			if template, ok := c.Messages[keys[i]+"."+category]; ok {
+				return c.render(template, issue), true
+			}
+		}
+		if template, ok := c.Messages[keys[i]]; ok {
+			return c.render(template, issue), true
+		}
+	}
+	return "", false
+}
+
+// errorOf renders an issue like Issue.Error, but with the index segments of
+// its path in the language of the catalog.
+func (c *Catalog) errorOf(issue Issue) string {
+	var b strings.Builder
+	for _, segment := range issue.Path {
+		if index, isIndex := segment.(int); isIndex {
+			if template, ok := c.Messages["index"]; ok {
+				b.WriteString(renderMessage(template, Params{"index": index}))
+				b.WriteString(": ")
+				continue
+			}
+		}
+		b.WriteString(Issue{Path: Path{segment}}.Error())
+	}
+	b.WriteString(issue.Message)
+	return b.String()
+}
+
+func (c *Catalog) render(template string, issue Issue) string {
+	params := Params{"path": issue.Path.String(), "received": issue.Received}
+	for key, param := range issue.Params {
+		params[key] = param
+	}
+	return renderMessage(template, params)
+}
+
+// Localize returns a copy of the result whose messages are translated with
+// the catalog of the locale. Issues the catalog has no message for fall back
+// to the DefaultLocale catalog, then to their original message, and
+// overridden messages are kept.
+func (r *Result[T]) Localize(locale string) *Result[T] {
+	catalog, ok := LookupCatalog(locale)
+	if !ok {
+		catalog, _ = LookupCatalog(DefaultLocale)
+	}
+	fallback, _ := LookupCatalog(DefaultLocale)
+
+	localized := &Result[T]{Ok: r.Ok, Value: r.Value, Path: r.Path}
+	for _, issue := range r.Issues {
+		if !issue.Overridden {
+			if message, ok := catalog.Message(issue); ok {
+				issue.Message = message
+			} else if message, ok := fallback.Message(issue); ok {
+				issue.Message = message
+			}
+		}
+		localized.Issues = append(localized.Issues, issue)
+		localized.Errors = append(localized.Errors, catalog.errorOf(issue))
+	}
+	return localized
+}
+
+func pluralCount(params Params) (float64, bool) {
+	for _, key := range []string{"min", "max", "length"} {
+		if count, ok := toFloat(params[key]); ok {
+			return count, true
+		}
+	}
+	return 0, false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case int:
+		return float64(value), true
+	case int8:
+		return float64(value), true
+	case int16:
+		return float64(value), true
+	case int32:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case uint:
+		return float64(value), true
+	case uint8:
+		return float64(value), true
+	case uint16:
+		return float64(value), true
+	case uint32:
+		return float64(value), true
+	case uint64:
+		return float64(value), true
+	case float32:
+		return float64(value), true
+	case float64:
+		return value, true
+	}
+	return 0, false
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
@@ -0,0 +1,115 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.RegisterCatalog("id", &core.Catalog{
+		Plural: core.PluralOther,
+		Messages: map[string]string{
+			"index":                        "Elemen ke-{index}",
+			core.InvalidType:               "Harus bertipe {expected}",
+			core.InvalidString + ".email":  "Harus berupa alamat email yang valid",
+			core.TooSmall + ".array.other": "Array harus memiliki minimal {min} elemen",
+		},
+	})
+}
+
+func TestResult_LocalizeEnglish(t *testing.T) {
+	schema := composites.NewArraySchema[string]("Tags", primitives.NewStringSchema("Tags").Min(3)).Min(1)
+
+	result := schema.Parse([]interface{}{}).Localize("en")
+	assert.Equal(t, []string{"Array must have at least 1 element"}, result.Errors)
+
+	result = schema.Parse([]interface{}{"go", "golang"}).Localize("en")
+	assert.Equal(t, []string{"Element at index 0: Must be at least 3 characters long"}, result.Errors)
+
+	result = composites.NewArraySchema[string]("Tags", primitives.NewStringSchema("Tags")).Min(2).Parse([]interface{}{}).Localize("en")
+	assert.Equal(t, []string{"Array must have at least 2 elements"}, result.Errors)
+}
+
+func TestResult_LocalizeRegisteredCatalog(t *testing.T) {
+	schema := composites.NewObjectSchema("User", composites.Shape{
+		"emails": composites.NewArraySchema[string]("emails", primitives.NewStringSchema("emails").Email()).Min(1),
+		"age":    primitives.NewNumberSchema[int]("age").Gte(18),
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"emails": []interface{}{"nope"},
+		"age":    "old",
+	})
+	localized := result.Localize("id-ID")
+
+	assert.False(t, localized.Ok)
+	assert.Equal(t, []string{
+		"age: Harus bertipe number",
+		"emails: Elemen ke-0: Harus berupa alamat email yang valid",
+		"emails: Array harus memiliki minimal 1 elemen",
+	}, localized.Errors)
+	assert.Equal(t, "Harus berupa alamat email yang valid", localized.Issues[1].Message)
+	assert.Equal(t, "emails[0]", localized.Issues[1].Path.String())
+
+	// The original result is left untouched.
+	assert.Equal(t, "age: Must be a number.", result.Errors[0])
+
+	result = schema.Parse(map[string]interface{}{"emails": []interface{}{}, "age": 18})
+	assert.Equal(t, []string{"emails: Array harus memiliki minimal 1 elemen"}, result.Localize("id").Errors)
+}
+
+func TestResult_LocalizeFallbacks(t *testing.T) {
+	schema := primitives.NewNumberSchema[int]("Age").Gt(17).Refine(func(value int) bool {
+		return value != 42
+	}, "Not that one")
+
+	// Messages missing from the catalog fall back to English.
+	result := schema.Parse(10).Localize("id")
+	assert.Equal(t, []string{"Must be greater than 17"}, result.Errors)
+
+	// Unknown locales use the default catalog.
+	result = schema.Parse(10).Localize("fr")
+	assert.Equal(t, []string{"Must be greater than 17"}, result.Errors)
+
+	// Custom issues keep their message.
+	result = schema.Parse(42).Localize("id")
+	assert.Equal(t, []string{"Not that one"}, result.Errors)
+}
+
+func TestResult_LocalizeOverridden(t *testing.T) {
+	schema := composites.NewObjectSchema("User", composites.Shape{
+		"email": primitives.NewStringSchema("email").Email("Enter your work email"),
+		"age": primitives.NewNumberSchema[int]("age").Messages(func(issue core.Issue) string {
+			return "Age is a number of years"
+		}),
+	})
+
+	result := schema.Parse(map[string]interface{}{"email": "nope", "age": "old"})
+	assert.True(t, result.Issues[0].Overridden)
+	assert.Equal(t, []string{
+		"age: Age is a number of years",
+		"email: Enter your work email",
+	}, result.Localize("id").Errors)
+}
+
+func TestNegotiateLocale(t *testing.T) {
+	assert.Equal(t, "id", core.NegotiateLocale("id"))
+	assert.Equal(t, "id-id", core.NegotiateLocale("ja;q=0.9, id-ID, en;q=0.8"))
+	assert.Equal(t, "id", core.NegotiateLocale("fr-FR, fr;q=0.9, id;q=0.5"))
+	assert.Equal(t, "en", core.NegotiateLocale("fr-FR, de;q=0.9"))
+	assert.Equal(t, "en", core.NegotiateLocale("id;q=0, *"))
+	assert.Equal(t, "en", core.NegotiateLocale(""))
+}
+
+func TestLookupCatalog(t *testing.T) {
+	catalog, ok := core.LookupCatalog("EN_us")
+	assert.True(t, ok)
+	assert.Same(t, core.English, catalog)
+
+	_, ok = core.LookupCatalog("ja")
+	assert.False(t, ok)
+}
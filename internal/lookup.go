@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"sync"
+)
+
+// Lookup checks values against a source outside the schema, such as a
+// database table. Rules depend on this interface rather than on a client, so
+// that tests can pass a MemoryLookup instead.
+type Lookup[T any] interface {
+	Exists(ctx context.Context, value T) (bool, error)
+}
+
+// MemoryLookup is a Lookup backed by a set held in memory. It is safe for
+// concurrent use.
+type MemoryLookup[T comparable] struct {
+	mu     sync.RWMutex
+	values map[T]struct{}
+}
+
+func NewMemoryLookup[T comparable](values ...T) *MemoryLookup[T] {
+	lookup := &MemoryLookup[T]{values: make(map[T]struct{}, len(values))}
+	lookup.Add(values...)
+	return lookup
+}
+
+func (l *MemoryLookup[T]) Add(values ...T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, value := range values {
+		l.values[value] = struct{}{}
+	}
+}
+
+func (l *MemoryLookup[T]) Remove(values ...T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, value := range values {
+		delete(l.values, value)
+	}
+}
+
+func (l *MemoryLookup[T]) Exists(ctx context.Context, value T) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, exists := l.values[value]
+	return exists, nil
+}
+
+// Exists adds a context rule that fails when the lookup does not know the
+// value, such as an order referencing a product that does not exist.
+func (s *Schema[T]) Exists(lookup Lookup[T], message ...string) {
+	s.AddContextRule(func(ctx context.Context, value T) *Result[T] {
+		exists, err := lookup.Exists(ctx, value)
+		if err != nil {
+			return s.errorResult(value, err)
+		}
+		if !exists {
+			return s.NewIssueResult(value, Issue{Code: NotFound, Message: "Does not exist"}, message...)
+		}
+		return s.NewSuccessResult()
+	})
+}
+
+// NotExists adds a context rule that fails when the lookup knows the value,
+// such as a username that is already taken.
+func (s *Schema[T]) NotExists(lookup Lookup[T], message ...string) {
+	s.AddContextRule(func(ctx context.Context, value T) *Result[T] {
+		exists, err := lookup.Exists(ctx, value)
+		if err != nil {
+			return s.errorResult(value, err)
+		}
+		if exists {
+			return s.NewIssueResult(value, Issue{Code: AlreadyExists, Message: "Already exists"}, message...)
+		}
+		return s.NewSuccessResult()
+	})
+}
@@ -0,0 +1,72 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingLookup struct{}
+
+func (failingLookup) Exists(ctx context.Context, value string) (bool, error) {
+	return false, errors.New("database is unavailable")
+}
+
+func TestMemoryLookup(t *testing.T) {
+	lookup := core.NewMemoryLookup("abyan", "majid")
+
+	exists, err := lookup.Exists(context.Background(), "abyan")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	lookup.Remove("abyan")
+	lookup.Add("cat")
+	exists, _ = lookup.Exists(context.Background(), "abyan")
+	assert.False(t, exists)
+	exists, _ = lookup.Exists(context.Background(), "cat")
+	assert.True(t, exists)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = lookup.Exists(ctx, "cat")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSchema_Exists(t *testing.T) {
+	products := core.NewMemoryLookup(1, 2, 3)
+	schema := primitives.NewNumberSchema[int]("product_id").Positive().Exists(products)
+
+	assert.True(t, schema.Parse(2).Ok)
+
+	result := schema.Parse(4)
+	assert.False(t, result.Ok)
+	assert.Equal(t, []core.Issue{{Code: core.NotFound, Received: "int", Message: "Does not exist"}}, result.Issues)
+
+	result = schema.Parse(-1)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+}
+
+func TestSchema_NotExists(t *testing.T) {
+	usernames := core.NewMemoryLookup("abyan")
+	schema := primitives.NewStringSchema("username").NotExists(usernames, "'{value}' is already taken")
+
+	assert.True(t, schema.Parse("majid").Ok)
+
+	result := schema.Parse("abyan")
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.AlreadyExists, result.Issues[0].Code)
+	assert.Equal(t, []string{"'abyan' is already taken"}, result.Errors)
+
+	result = primitives.NewStringSchema("username").NotExists(failingLookup{}).Parse("abyan")
+	assert.Equal(t, []string{"database is unavailable"}, result.Errors)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result = schema.ParseContext(ctx, "majid")
+	assert.Equal(t, core.Canceled, result.Issues[0].Code)
+}
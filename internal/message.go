@@ -0,0 +1,61 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MessageFunc picks the message of an issue raised by a schema. Returning an
+// empty string keeps the default message.
+type MessageFunc func(issue Issue) string
+
+var placeholderRegex = regexp.MustCompile(`\{(\w+)\}`)
+
+// message resolves the message of an issue: a per-rule override wins over the
+// schema-wide MessageFunc, which wins over the built-in default. Overrides are
+// templates whose {path}, {value} and param placeholders get filled in, and
+// are reported as such.
+func (s *Schema[T]) message(issue Issue, value interface{}, overrides []string) (string, bool) {
+	params := Params{"path": s.Path, "value": value}
+	for key, param := range issue.Params {
+		params[key] = param
+	}
+
+	if len(overrides) > 0 && overrides[0] != "" {
+		return renderMessage(overrides[0], params), true
+	}
+	if s.MessageFunc != nil {
+		if message := s.MessageFunc(issue); message != "" {
+			return renderMessage(message, params), true
+		}
+	}
+	return issue.Message, false
+}
+
+func renderMessage(template string, params Params) string {
+	return placeholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		if param, ok := params[placeholder[1:len(placeholder)-1]]; ok {
+			return formatParam(param)
+		}
+		return placeholder
+	})
+}
+
+func formatParam(param interface{}) string {
+	if t, ok := param.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+
+	v := reflect.ValueOf(param)
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		elements := make([]string, v.Len())
+		for i := range elements {
+			elements[i] = formatParam(v.Index(i).Interface())
+		}
+		return strings.Join(elements, ", ")
+	}
+	return fmt.Sprint(param)
+}
@@ -0,0 +1,76 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/coercion"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIssueResult_MessageOverride(t *testing.T) {
+	schema := primitives.NewStringSchema("Username").
+		Min(3, "{path} needs {min}+ characters, got '{value}'").
+		Max(5)
+
+	result := schema.Parse("ab")
+	assert.Equal(t, []string{"Username needs 3+ characters, got 'ab'"}, result.Errors)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+
+	result = schema.Parse("abcdef")
+	assert.Equal(t, []string{"Must be at most 5 characters long"}, result.Errors)
+}
+
+func TestNewIssueResult_UnknownPlaceholder(t *testing.T) {
+	schema := primitives.NewStringSchema("Email").Email("{path} is not an {unknown} email")
+
+	result := schema.Parse("nope")
+	assert.Equal(t, []string{"Email is not an {unknown} email"}, result.Errors)
+}
+
+func TestNewIssueResult_FormatsParams(t *testing.T) {
+	schema := literals.NewEnumSchema("Major", []string{"CS", "SE"}).Messages(func(issue core.Issue) string {
+		if issue.Code == core.NotInEnum {
+			return "{value} is not one of {allowed}"
+		}
+		return ""
+	})
+
+	result := schema.Parse("Law")
+	assert.Equal(t, []string{"Law is not one of CS, SE"}, result.Errors)
+
+	result = schema.Parse(1)
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+	assert.NotContains(t, result.Errors[0], "{")
+}
+
+func TestSchema_MessageFunc(t *testing.T) {
+	messages := func(issue core.Issue) string {
+		switch issue.Code {
+		case core.InvalidType:
+			return "Please enter a number"
+		case core.TooSmall:
+			return "Please enter at least {min}"
+		}
+		return ""
+	}
+	schema := coercion.NewCoerceNumberSchema[int]("Age").Gte(18).Lte(100, "Nobody is {value}").Messages(messages)
+
+	assert.Equal(t, []string{"Please enter a number"}, schema.Parse("abc").Errors)
+	assert.Equal(t, []string{"Please enter at least 18"}, schema.Parse("10").Errors)
+	assert.Equal(t, []string{"Nobody is 120"}, schema.Parse("120").Errors)
+}
+
+func TestSchema_MessageFuncOnComposites(t *testing.T) {
+	schema := composites.NewArraySchema[string]("Tags", primitives.NewStringSchema("Tags")).
+		Min(2).
+		Messages(func(issue core.Issue) string {
+			return "{path} needs at least {min} tags"
+		})
+
+	result := schema.Parse([]interface{}{"go"})
+	assert.Equal(t, []string{"Tags needs at least 2 tags"}, result.Errors)
+}
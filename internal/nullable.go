@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"reflect"
+)
+
+type NullableSchema[T any] struct {
+	Inner Parser[T]
+}
+
+func NewNullableSchema[T any](inner Parser[T]) *NullableSchema[T] {
+	return &NullableSchema[T]{Inner: inner}
+}
+
+func (s *NullableSchema[T]) Parse(value interface{}) *Result[*T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *NullableSchema[T]) ParseContext(ctx context.Context, value interface{}) *Result[*T] {
+	return ParseContext[*T](ctx, s, value)
+}
+
+func (s *NullableSchema[T]) ParseState(value interface{}, state *State) *Result[*T] {
+	if isNil(value) {
+		return &Result[*T]{Ok: true}
+	}
+
+	return toPointerResult(ParseState(s.Inner, value, state))
+}
+
+func (s *NullableSchema[T]) ParseTyped(value *T) *Result[*T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *NullableSchema[T]) ParseTypedState(value *T, state *State) *Result[*T] {
+	if value == nil {
+		return &Result[*T]{Ok: true}
+	}
+
+	return toPointerResult(ParseTypedState(s.Inner, *value, state))
+}
+
+func (s *NullableSchema[T]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *NullableSchema[T]) Describe() *Description {
+	description := Describe(s.Inner)
+	description.Nullable = true
+	return description
+}
+
+func (s *NullableSchema[T]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *NullableSchema[T]) ParseAbsent(state *State) (*Result[interface{}], bool) {
+	return ParseAbsent(s.Inner, state)
+}
+
+func toPointerResult[T any](result *Result[T]) *Result[*T] {
+	pointerResult := &Result[*T]{
+		Ok:     result.Ok,
+		Path:   result.Path,
+		Errors: result.Errors,
+		Issues: result.Issues,
+	}
+	if result.Ok {
+		value := result.Value
+		pointerResult.Value = &value
+	}
+	return pointerResult
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
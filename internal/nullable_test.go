@@ -0,0 +1,52 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullableSchema_Parse(t *testing.T) {
+	schema := core.NewNullableSchema[string](primitives.NewStringSchema("Nickname").Min(2))
+
+	result := schema.Parse(nil)
+	assert.True(t, result.Ok)
+	assert.Nil(t, result.Value)
+
+	result = schema.Parse((*string)(nil))
+	assert.True(t, result.Ok)
+	assert.Nil(t, result.Value)
+
+	result = schema.Parse("Aby")
+	assert.True(t, result.Ok)
+	assert.Equal(t, "Aby", *result.Value)
+
+	result = schema.Parse("A")
+	assert.False(t, result.Ok)
+	assert.Nil(t, result.Value)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+}
+
+func TestNullableSchema_ParseTyped(t *testing.T) {
+	schema := core.NewNullableSchema[int](primitives.NewNumberSchema[int]("Age").Positive())
+	age := 3
+
+	assert.True(t, schema.ParseTyped(nil).Ok)
+	assert.Equal(t, 3, *schema.ParseTyped(&age).Value)
+
+	age = -3
+	assert.False(t, schema.ParseTyped(&age).Ok)
+}
+
+func TestNullableSchema_ParseAbsent(t *testing.T) {
+	schema := core.NewNullableSchema[string](primitives.NewStringSchema("Nickname"))
+	result, _ := schema.ParseAbsent(nil)
+	assert.False(t, result.Ok)
+
+	schema = core.NewNullableSchema[string](core.NewOptionalSchema[string](primitives.NewStringSchema("Nickname")))
+	result, present := schema.ParseAbsent(nil)
+	assert.True(t, result.Ok)
+	assert.False(t, present)
+}
@@ -0,0 +1,411 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/jsonschema"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+)
+
+const ValidationErrorName = "ValidationError"
+
+// DefaultMaxBodyBytes is the size above which request bodies are rejected,
+// unless changed with MaxBodyBytes.
+const DefaultMaxBodyBytes = 1 << 20
+
+// ValidationError is the body of the 400 response sent for requests whose
+// parameters or body failed validation.
+type ValidationError struct {
+	Issues []ValidationIssue `json:"issues"`
+}
+
+// ValidationIssue is an issue found in the path parameters, query
+// parameters or body of a request, as told by In.
+type ValidationIssue struct {
+	In      string `json:"in"`
+	Path    string `json:"path"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+var validationErrorSchema = composites.NewObjectSchema(ValidationErrorName, composites.Shape{
+	"issues": composites.NewArraySchema[map[string]interface{}]("issues", composites.NewObjectSchema("issue", composites.Shape{
+		"in":      literals.NewEnumSchema("in", []string{"path", "query", "body"}),
+		"path":    primitives.NewStringSchema("path"),
+		"code":    primitives.NewStringSchema("code"),
+		"message": primitives.NewStringSchema("message"),
+	})),
+}).Description("The request failed validation")
+
+// bodySchema reports bodies that are not valid JSON the way schemas report
+// values of the wrong type.
+var bodySchema = &core.Schema[interface{}]{}
+
+// Route describes an operation of an API.
+type Route struct {
+	Method string
+	// Path is a pattern as accepted by http.ServeMux, such as /users/{id}.
+	Path string
+
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+
+	// Params and Query are object schemas whose fields are the path and
+	// query parameters of the route. Parameters are received as strings, so
+	// fields of other types need coercion schemas, and query fields that are
+	// arrays receive every value of a repeated key.
+	Params core.AnyParser
+	Query  core.AnyParser
+	// Body parses the decoded JSON body of requests. Wrap schemas that parse
+	// Go types, such as struct schemas, with Typed.
+	Body core.AnyParser
+
+	// Responses maps status codes to the schemas of the JSON bodies sent
+	// with them, or to nil for responses without a body.
+	Responses map[int]interface{}
+}
+
+// Request is a request that passed validation, along with the values parsed
+// from its parameters and body.
+type Request struct {
+	*http.Request
+	Params interface{}
+	Query  interface{}
+	Body   interface{}
+}
+
+type HandlerFunc func(w http.ResponseWriter, r *Request)
+
+// API validates requests against the schemas their routes were registered
+// with, and builds its OpenAPI document from the same routes, so that the
+// document cannot drift from what the server accepts.
+type API struct {
+	info         Info
+	mux          *http.ServeMux
+	routes       []*route
+	components   map[string]interface{}
+	maxBodyBytes int64
+}
+
+type route struct {
+	Route
+	params *core.Description
+	query  *core.Description
+}
+
+func NewAPI(info Info) *API {
+	return &API{
+		info:         info,
+		mux:          http.NewServeMux(),
+		components:   map[string]interface{}{ValidationErrorName: validationErrorSchema},
+		maxBodyBytes: DefaultMaxBodyBytes,
+	}
+}
+
+// MaxBodyBytes sets the size above which request bodies are answered with a
+// 413 response.
+func (a *API) MaxBodyBytes(limit int64) *API {
+	a.maxBodyBytes = limit
+	return a
+}
+
+// Component names a schema, so that the document references it wherever it
+// is used instead of repeating it.
+func (a *API) Component(name string, schema interface{}) *API {
+	a.components[name] = schema
+	return a
+}
+
+// Handle registers the handler of a route. Requests whose parameters or body
+// fail validation are answered with a ValidationError and never reach the
+// handler.
+func (a *API) Handle(route Route, handler HandlerFunc) *API {
+	if route.Method == "" {
+		panic(fmt.Sprintf("openapi: route %s has no method", route.Path))
+	}
+
+	registered := newRoute(route)
+	a.routes = append(a.routes, registered)
+	a.mux.HandleFunc(route.Method+" "+route.Path, func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, a.maxBodyBytes)
+		registered.serve(w, r, handler)
+	})
+	return a
+}
+
+// ServeDocument serves the document of the API at path, encoded as YAML when
+// the path ends in .yaml or .yml and as JSON otherwise.
+func (a *API) ServeDocument(path string) *API {
+	encodesYAML := strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")
+	a.mux.HandleFunc(http.MethodGet+" "+path, func(w http.ResponseWriter, r *http.Request) {
+		var encoded []byte
+		var err error
+		if encodesYAML {
+			w.Header().Set("Content-Type", "application/yaml")
+			encoded, err = a.Document().YAML()
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			encoded, err = a.Document().JSON()
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(encoded)
+	})
+	return a
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mux.ServeHTTP(w, r)
+}
+
+// Document builds the OpenAPI document of the routes registered so far.
+func (a *API) Document() *Document {
+	exporter := jsonschema.NewExporter(ComponentsPrefix)
+	defineAll(exporter, a.components)
+
+	document := &Document{OpenAPI: Version, Info: a.info, Paths: map[string]map[string]Operation{}}
+	for _, route := range a.routes {
+		path := documentPath(route.Path)
+		if document.Paths[path] == nil {
+			document.Paths[path] = map[string]Operation{}
+		}
+		document.Paths[path][strings.ToLower(route.Method)] = route.operation(exporter)
+	}
+
+	document.Components = &Components{Schemas: exporter.Definitions()}
+	return document
+}
+
+func newRoute(r Route) *route {
+	registered := &route{Route: r}
+	if r.Params != nil {
+		registered.params = describeParameters(r.Params)
+	}
+	if r.Query != nil {
+		registered.query = describeParameters(r.Query)
+	}
+	return registered
+}
+
+func describeParameters(schema core.AnyParser) *core.Description {
+	description := core.Describe(schema)
+	if description.Kind == core.KindLazy {
+		description = description.Resolve()
+	}
+	if description.Kind != core.KindObject {
+		panic(fmt.Sprintf("openapi: parameters must be an object schema, got %s", description.Kind))
+	}
+	return description
+}
+
+func (r *route) serve(w http.ResponseWriter, req *http.Request, handler HandlerFunc) {
+	state := core.NewState(core.ParseOptions{}).WithContext(req.Context())
+	request := &Request{Request: req}
+	var issues []ValidationIssue
+
+	if r.Params != nil {
+		values := map[string]interface{}{}
+		for name := range r.params.Fields {
+			if value := req.PathValue(name); value != "" {
+				values[name] = value
+			}
+		}
+		request.Params, issues = parseIn("path", r.Params, values, state, issues)
+	}
+
+	if r.Query != nil {
+		query := req.URL.Query()
+		values := map[string]interface{}{}
+		for name, field := range r.query.Fields {
+			if _, isPresent := query[name]; !isPresent {
+				continue
+			}
+			if field.Kind == core.KindArray || field.Kind == core.KindSet {
+				elements := make([]interface{}, len(query[name]))
+				for i, element := range query[name] {
+					elements[i] = element
+				}
+				values[name] = elements
+			} else {
+				values[name] = query.Get(name)
+			}
+		}
+		request.Query, issues = parseIn("query", r.Query, values, state, issues)
+	}
+
+	if r.Body != nil {
+		body, err := decodeBody(r.Body, req.Body)
+		var maxBytesError *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxBytesError):
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		case err != nil:
+			result := bodySchema.NewIssueResult(nil, core.Issue{
+				Code:    core.InvalidType,
+				Params:  core.Params{"expected": "json"},
+				Message: "Must be valid JSON.",
+			})
+			issues = appendIssues("body", result.Issues, issues)
+		default:
+			request.Body, issues = parseIn("body", r.Body, body, state, issues)
+		}
+	}
+
+	if len(issues) > 0 {
+		writeJSON(w, http.StatusBadRequest, ValidationError{Issues: issues})
+		return
+	}
+	handler(w, request)
+}
+
+func parseIn(in string, schema core.AnyParser, value interface{}, state *core.State, issues []ValidationIssue) (interface{}, []ValidationIssue) {
+	result := core.ParseAnyState(schema, value, state)
+	return result.Value, appendIssues(in, result.Issues, issues)
+}
+
+func appendIssues(in string, found []core.Issue, issues []ValidationIssue) []ValidationIssue {
+	for _, issue := range found {
+		issues = append(issues, ValidationIssue{In: in, Path: issue.Path.String(), Code: issue.Code, Message: issue.Message})
+	}
+	return issues
+}
+
+func decodeBody(schema core.AnyParser, body io.Reader) (interface{}, error) {
+	if body == nil {
+		return nil, nil
+	}
+	if typed, isTyped := schema.(bodyDecoder); isTyped {
+		return typed.decode(body)
+	}
+
+	var value interface{}
+	if err := decodeJSON(body, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// decodeJSON decodes a body holding a single JSON value, if any.
+func decodeJSON(body io.Reader, value interface{}) error {
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(value); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	var trailing json.RawMessage
+	if err := decoder.Decode(&trailing); !errors.Is(err, io.EOF) {
+		if err != nil {
+			return err
+		}
+		return errors.New("unexpected data after the JSON value")
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+var wildcardRegex = regexp.MustCompile(`\{([^}.]*)(\.\.\.)?\}`)
+
+// documentPath turns a pattern of http.ServeMux into an OpenAPI path, which
+// drops the host and the trailing {$} and spells {name...} as {name}.
+func documentPath(pattern string) string {
+	if slash := strings.Index(pattern, "/"); slash > 0 {
+		pattern = pattern[slash:]
+	}
+	pattern = strings.TrimSuffix(pattern, "{$}")
+	return wildcardRegex.ReplaceAllString(pattern, "{$1}")
+}
+
+func (r *route) operation(exporter *jsonschema.Exporter) Operation {
+	operation := Operation{
+		OperationID: r.OperationID,
+		Summary:     r.Summary,
+		Description: r.Description,
+		Tags:        r.Tags,
+		Responses:   map[string]Response{},
+	}
+
+	operation.Parameters = append(operation.Parameters, parameters("path", r.params, exporter)...)
+	operation.Parameters = append(operation.Parameters, parameters("query", r.query, exporter)...)
+
+	if r.Body != nil {
+		description := core.Describe(r.Body)
+		operation.RequestBody = &RequestBody{
+			Required: !description.Optional,
+			Content:  map[string]MediaType{"application/json": {Schema: exporter.ConvertDescription(description)}},
+		}
+	}
+
+	for status, schema := range r.Responses {
+		response := Response{Description: http.StatusText(status)}
+		if schema != nil {
+			response.Content = map[string]MediaType{"application/json": {Schema: exporter.Convert(schema)}}
+		}
+		operation.Responses[strconv.Itoa(status)] = response
+	}
+
+	validates := r.Params != nil || r.Query != nil || r.Body != nil
+	if _, isDocumented := r.Responses[http.StatusBadRequest]; validates && !isDocumented {
+		operation.Responses[strconv.Itoa(http.StatusBadRequest)] = Response{
+			Description: http.StatusText(http.StatusBadRequest),
+			Content:     map[string]MediaType{"application/json": {Schema: exporter.Convert(validationErrorSchema)}},
+		}
+	}
+	if len(operation.Responses) == 0 {
+		operation.Responses["default"] = Response{Description: "Default response"}
+	}
+	return operation
+}
+
+func parameters(in string, description *core.Description, exporter *jsonschema.Exporter) []Parameter {
+	if description == nil {
+		return nil
+	}
+
+	required := make(map[string]bool, len(description.Required))
+	for _, name := range description.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(description.Fields))
+	for name := range description.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parameters := make([]Parameter, 0, len(names))
+	for _, name := range names {
+		field := description.Fields[name]
+		parameters = append(parameters, Parameter{
+			Name:        name,
+			In:          in,
+			Description: field.Doc,
+			Required:    in == "path" || required[name],
+			Schema:      exporter.ConvertDescription(field),
+		})
+	}
+	return parameters
+}
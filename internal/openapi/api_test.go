@@ -0,0 +1,196 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/abyanmajid/v/internal/coercion"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/openapi"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+type newPet struct {
+	Name string `json:"name" v:"required,min=1"`
+	Kind string `json:"kind" v:"oneof=cat|dog"`
+}
+
+func newPetAPI() *openapi.API {
+	pet := composites.NewObjectSchema("Pet", composites.Shape{
+		"id":   primitives.NewNumberSchema[float64]("id"),
+		"name": primitives.NewStringSchema("name"),
+	})
+
+	api := openapi.NewAPI(openapi.Info{Title: "Pets", Version: "1.0.0"}).Component("Pet", pet)
+
+	api.Handle(openapi.Route{
+		Method:      http.MethodGet,
+		Path:        "/pets/{id}",
+		OperationID: "getPet",
+		Params: composites.NewObjectSchema("params", composites.Shape{
+			"id": coercion.NewCoerceNumberSchema[int]("id").Gte(1),
+		}),
+		Query: composites.NewObjectSchema("query", composites.Shape{
+			"fields": composites.NewArraySchema[string]("fields", primitives.NewStringSchema("field")),
+		}).Partial(),
+		Responses: map[int]interface{}{http.StatusOK: pet, http.StatusNotFound: nil},
+	}, func(w http.ResponseWriter, r *openapi.Request) {
+		params := r.Params.(map[string]interface{})
+		query := r.Query.(map[string]interface{})
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": params["id"], "fields": query["fields"]})
+	})
+
+	api.Handle(openapi.Route{
+		Method:    http.MethodPost,
+		Path:      "/pets",
+		Tags:      []string{"pets"},
+		Body:      openapi.Typed(composites.NewStructSchema[newPet]("NewPet")),
+		Responses: map[int]interface{}{http.StatusCreated: pet},
+	}, func(w http.ResponseWriter, r *openapi.Request) {
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 1, "name": r.Body.(newPet).Name})
+	})
+
+	return api.ServeDocument("/openapi.json")
+}
+
+func serve(api *openapi.API, method string, target string, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	api.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+	return recorder
+}
+
+func TestAPI_Validation(t *testing.T) {
+	api := newPetAPI()
+
+	response := serve(api, http.MethodGet, "/pets/7?fields=name&fields=id", "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.JSONEq(t, `{"id": 7, "fields": ["name", "id"]}`, response.Body.String())
+
+	response = serve(api, http.MethodGet, "/pets/0", "")
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.JSONEq(t, `{"issues": [{"in": "path", "path": "id", "code": "too_small", "message": "Must be greater than or equal to 1"}]}`, response.Body.String())
+
+	response = serve(api, http.MethodPost, "/pets", `{"name": "Rex", "kind": "dog"}`)
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.JSONEq(t, `{"id": 1, "name": "Rex"}`, response.Body.String())
+
+	response = serve(api, http.MethodPost, "/pets", `{"name": "", "kind": "bird"}`)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	var validationError openapi.ValidationError
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &validationError))
+	assert.Len(t, validationError.Issues, 2)
+	assert.Equal(t, "body", validationError.Issues[0].In)
+
+	response = serve(api, http.MethodPost, "/pets", `{"name":`)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.Contains(t, response.Body.String(), `"code":"invalid_type"`)
+}
+
+func TestAPI_Body(t *testing.T) {
+	api := newPetAPI()
+
+	response := serve(api, http.MethodPost, "/pets", `{"name": "Rex", "kind": "dog"} {"name": "Max", "kind": "cat"}`)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.JSONEq(t, `{"issues": [{"in": "body", "path": "", "code": "invalid_type", "message": "Must be valid JSON."}]}`, response.Body.String())
+
+	response = serve(api, http.MethodPost, "/pets", `{"name": "Rex", "kind": "dog"}`+"\n")
+	assert.Equal(t, http.StatusCreated, response.Code)
+
+	api.MaxBodyBytes(16)
+	response = serve(api, http.MethodPost, "/pets", `{"name": "Rex", "kind": "dog"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+}
+
+func TestAPI_Document(t *testing.T) {
+	response := serve(newPetAPI(), http.MethodGet, "/openapi.json", "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "application/json", response.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"openapi": "3.1.0",
+		"info": {"title": "Pets", "version": "1.0.0"},
+		"paths": {
+			"/pets/{id}": {
+				"get": {
+					"operationId": "getPet",
+					"parameters": [
+						{"name": "id", "in": "path", "required": true, "schema": {"type": "integer", "minimum": 1}},
+						{"name": "fields", "in": "query", "schema": {"type": "array", "items": {"type": "string"}}}
+					],
+					"responses": {
+						"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}},
+						"400": {"description": "Bad Request", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ValidationError"}}}},
+						"404": {"description": "Not Found"}
+					}
+				}
+			},
+			"/pets": {
+				"post": {
+					"tags": ["pets"],
+					"requestBody": {
+						"required": true,
+						"content": {"application/json": {"schema": {
+							"type": "object",
+							"properties": {
+								"name": {"type": "string", "minLength": 1},
+								"kind": {"enum": ["cat", "dog"]}
+							},
+							"required": ["name", "kind"],
+							"additionalProperties": false
+						}}}
+					},
+					"responses": {
+						"201": {"description": "Created", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}},
+						"400": {"description": "Bad Request", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ValidationError"}}}}
+					}
+				}
+			}
+		},
+		"components": {
+			"schemas": {
+				"Pet": {
+					"type": "object",
+					"properties": {"id": {"type": "number"}, "name": {"type": "string"}},
+					"required": ["id", "name"],
+					"additionalProperties": false
+				},
+				"ValidationError": {
+					"type": "object",
+					"description": "The request failed validation",
+					"properties": {
+						"issues": {
+							"type": "array",
+							"items": {
+								"type": "object",
+								"properties": {
+									"in": {"enum": ["path", "query", "body"]},
+									"path": {"type": "string"},
+									"code": {"type": "string"},
+									"message": {"type": "string"}
+								},
+								"required": ["code", "in", "message", "path"],
+								"additionalProperties": false
+							}
+						}
+					},
+					"required": ["issues"],
+					"additionalProperties": false
+				}
+			}
+		}
+	}`, response.Body.String())
+}
+
+func TestAPI_DocumentYAML(t *testing.T) {
+	api := newPetAPI().ServeDocument("/docs/openapi.yaml")
+
+	response := serve(api, http.MethodGet, "/docs/openapi.yaml", "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "application/yaml", response.Header().Get("Content-Type"))
+	assert.Contains(t, response.Body.String(), "openapi: 3.1.0\n")
+	assert.Contains(t, response.Body.String(), "operationId: getPet")
+}
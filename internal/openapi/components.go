@@ -0,0 +1,48 @@
+package openapi
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/abyanmajid/v/internal/jsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+const ComponentsPrefix = "#/components/schemas/"
+
+// Components is the components object of an OpenAPI 3.1 document, whose
+// schemas are JSON Schema (draft 2020-12) documents.
+type Components struct {
+	Schemas map[string]jsonschema.Schema `json:"schemas,omitempty" yaml:"schemas,omitempty"`
+}
+
+// NewComponents converts named schemas into component schemas. A schema used
+// inside another one is referenced by name rather than repeated, and
+// recursive schemas are added as components of their own.
+func NewComponents(schemas map[string]interface{}) *Components {
+	exporter := jsonschema.NewExporter(ComponentsPrefix)
+	defineAll(exporter, schemas)
+	return &Components{Schemas: exporter.Definitions()}
+}
+
+func defineAll(exporter *jsonschema.Exporter, schemas map[string]interface{}) {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		exporter.Define(name, schemas[name])
+	}
+}
+
+// JSON encodes the components as the components section of a document.
+func (c *Components) JSON() ([]byte, error) {
+	return json.MarshalIndent(map[string]interface{}{"components": c}, "", "  ")
+}
+
+// YAML encodes the components as the components section of a document.
+func (c *Components) YAML() ([]byte, error) {
+	return yaml.Marshal(map[string]interface{}{"components": c})
+}
@@ -0,0 +1,95 @@
+package openapi_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/openapi"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func newComponents() *openapi.Components {
+	address := composites.NewObjectSchema("Address", composites.Shape{
+		"city": primitives.NewStringSchema("City").Min(1),
+	}).Description("A postal address")
+
+	user := composites.NewObjectSchema("User", composites.Shape{
+		"name":     primitives.NewStringSchema("Name").Description("Full name").Example("Abyan Majid"),
+		"nickname": core.NewOptionalSchema[string](primitives.NewStringSchema("Nickname")),
+		"address":  core.NewNullableSchema[map[string]interface{}](address),
+		"shipping": composites.NewArraySchema[map[string]interface{}]("Shipping", address),
+	})
+
+	return openapi.NewComponents(map[string]interface{}{
+		"Address": address,
+		"User":    user,
+	})
+}
+
+func TestNewComponents_JSON(t *testing.T) {
+	document, err := newComponents().JSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"components": {
+			"schemas": {
+				"Address": {
+					"type": "object",
+					"description": "A postal address",
+					"properties": {"city": {"type": "string", "minLength": 1}},
+					"required": ["city"],
+					"additionalProperties": false
+				},
+				"User": {
+					"type": "object",
+					"properties": {
+						"name": {"type": "string", "description": "Full name", "examples": ["Abyan Majid"]},
+						"nickname": {"type": "string"},
+						"address": {"anyOf": [{"$ref": "#/components/schemas/Address"}, {"type": "null"}]},
+						"shipping": {"type": "array", "items": {"$ref": "#/components/schemas/Address"}}
+					},
+					"required": ["address", "name", "shipping"],
+					"additionalProperties": false
+				}
+			}
+		}
+	}`, string(document))
+}
+
+func TestNewComponents_YAML(t *testing.T) {
+	document, err := newComponents().YAML()
+	assert.NoError(t, err)
+	assert.Contains(t, string(document), "components:\n    schemas:\n        Address:\n")
+	assert.Contains(t, string(document), "$ref: '#/components/schemas/Address'")
+	assert.Contains(t, string(document), "description: Full name")
+}
+
+type category struct {
+	Name     string     `json:"name" v:"required"`
+	Children []category `json:"children"`
+}
+
+func TestNewComponents_Recursive(t *testing.T) {
+	components := openapi.NewComponents(map[string]interface{}{
+		"Category": composites.NewStructSchema[category]("Category"),
+	})
+
+	document, err := components.JSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"components": {
+			"schemas": {
+				"Category": {
+					"type": "object",
+					"properties": {
+						"name": {"type": "string"},
+						"children": {"type": "array", "items": {"$ref": "#/components/schemas/Category"}}
+					},
+					"required": ["name", "children"],
+					"additionalProperties": false
+				}
+			}
+		}
+	}`, string(document))
+}
@@ -0,0 +1,67 @@
+package openapi
+
+import (
+	"encoding/json"
+
+	"github.com/abyanmajid/v/internal/jsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+const Version = "3.1.0"
+
+// Document is an OpenAPI 3.1 document. Paths maps each path to its
+// operations, keyed by lowercase HTTP method.
+type Document struct {
+	OpenAPI    string                          `json:"openapi" yaml:"openapi"`
+	Info       Info                            `json:"info" yaml:"info"`
+	Paths      map[string]map[string]Operation `json:"paths" yaml:"paths"`
+	Components *Components                     `json:"components,omitempty" yaml:"components,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title" yaml:"title"`
+	Version     string `json:"version" yaml:"version"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type Operation struct {
+	OperationID string              `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Summary     string              `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description string              `json:"description,omitempty" yaml:"description,omitempty"`
+	Tags        []string            `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses" yaml:"responses"`
+}
+
+// Parameter is a path or query parameter. Array parameters are sent by
+// repeating the key, as in ?tag=a&tag=b.
+type Parameter struct {
+	Name        string            `json:"name" yaml:"name"`
+	In          string            `json:"in" yaml:"in"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Required    bool              `json:"required,omitempty" yaml:"required,omitempty"`
+	Schema      jsonschema.Schema `json:"schema" yaml:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty" yaml:"required,omitempty"`
+	Content  map[string]MediaType `json:"content" yaml:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description" yaml:"description"`
+	Content     map[string]MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema jsonschema.Schema `json:"schema" yaml:"schema"`
+}
+
+func (d *Document) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+func (d *Document) YAML() ([]byte, error) {
+	return yaml.Marshal(d)
+}
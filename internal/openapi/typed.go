@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"io"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+type bodyDecoder interface {
+	decode(body io.Reader) (interface{}, error)
+}
+
+// TypedBody is a request body that is decoded into a T, rather than into
+// maps and slices, before it is parsed.
+type TypedBody[T any] struct {
+	schema core.Parser[T]
+}
+
+// Typed lets schemas that parse Go types, such as struct schemas, parse
+// request bodies. The handler receives the parsed T as the body.
+func Typed[T any](schema core.Parser[T]) *TypedBody[T] {
+	return &TypedBody[T]{schema: schema}
+}
+
+func (b *TypedBody[T]) decode(body io.Reader) (interface{}, error) {
+	var value T
+	if err := decodeJSON(body, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (b *TypedBody[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return b.ParseAnyState(value, nil)
+}
+
+func (b *TypedBody[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return core.ParseState(b.schema, value, state).ToAny()
+}
+
+func (b *TypedBody[T]) Describe() *core.Description {
+	return core.Describe(b.schema)
+}
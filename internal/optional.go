@@ -0,0 +1,73 @@
+package core
+
+import "context"
+
+// AbsentParser is implemented by schemas that tolerate a value being absent
+// from its parent, as opposed to being present but nil. ParseAbsent reports
+// whether the parent should hold the value of the returned result.
+type AbsentParser interface {
+	ParseAbsent(state *State) (result *Result[interface{}], present bool)
+}
+
+type OptionalSchema[T any] struct {
+	Inner Parser[T]
+}
+
+func NewOptionalSchema[T any](inner Parser[T]) *OptionalSchema[T] {
+	return &OptionalSchema[T]{Inner: inner}
+}
+
+func (s *OptionalSchema[T]) Parse(value interface{}) *Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *OptionalSchema[T]) ParseContext(ctx context.Context, value interface{}) *Result[T] {
+	return ParseContext[T](ctx, s, value)
+}
+
+func (s *OptionalSchema[T]) ParseState(value interface{}, state *State) *Result[T] {
+	return ParseState(s.Inner, value, state)
+}
+
+func (s *OptionalSchema[T]) ParseTyped(value T) *Result[T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *OptionalSchema[T]) ParseTypedState(value T, state *State) *Result[T] {
+	return ParseTypedState(s.Inner, value, state)
+}
+
+func (s *OptionalSchema[T]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *OptionalSchema[T]) Describe() *Description {
+	description := Describe(s.Inner)
+	description.Optional = true
+	return description
+}
+
+func (s *OptionalSchema[T]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *OptionalSchema[T]) ParseAbsent(state *State) (*Result[interface{}], bool) {
+	return &Result[interface{}]{Ok: true}, false
+}
+
+// Unwrap returns the inner schema, which rejects absent values again.
+func (s *OptionalSchema[T]) Unwrap() AnyParser {
+	return ToAnyParser(s.Inner)
+}
+
+// ParseAbsent resolves a value that is absent from its parent, failing with a
+// required issue unless the schema is an AbsentParser.
+func ParseAbsent(schema interface{}, state *State) (*Result[interface{}], bool) {
+	if absentParser, isAbsentParser := schema.(AbsentParser); isAbsentParser {
+		return absentParser.ParseAbsent(state)
+	}
+
+	result := &Result[interface{}]{}
+	result.AddIssues(Issue{Code: Required, Received: "absent", Message: "Missing required key"})
+	return result, false
+}
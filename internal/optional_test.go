@@ -0,0 +1,40 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionalSchema(t *testing.T) {
+	schema := core.NewOptionalSchema[string](primitives.NewStringSchema("Nickname").Min(2))
+
+	result := schema.Parse("Aby")
+	assert.True(t, result.Ok)
+	assert.Equal(t, "Aby", result.Value)
+
+	result = schema.ParseTyped("A")
+	assert.False(t, result.Ok)
+
+	result = schema.Parse(nil)
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Must be a string."}, result.Errors)
+
+	absentResult, present := schema.ParseAbsent(nil)
+	assert.True(t, absentResult.Ok)
+	assert.False(t, present)
+}
+
+func TestParseAbsent(t *testing.T) {
+	result, present := core.ParseAbsent(primitives.NewStringSchema("Name"), nil)
+	assert.False(t, result.Ok)
+	assert.False(t, present)
+	assert.Equal(t, core.Required, result.Issues[0].Code)
+	assert.Equal(t, []string{"Missing required key"}, result.Errors)
+
+	result, present = core.ParseAbsent(core.NewOptionalSchema[string](primitives.NewStringSchema("Name")), nil)
+	assert.True(t, result.Ok)
+	assert.False(t, present)
+}
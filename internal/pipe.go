@@ -0,0 +1,53 @@
+package core
+
+import "context"
+
+// PipeSchema feeds the output of its first schema into its second schema, so
+// that the second schema parses an already validated (or coerced) value.
+type PipeSchema[A any, B any] struct {
+	First  Parser[A]
+	Second Parser[B]
+}
+
+func NewPipeSchema[A any, B any](first Parser[A], second Parser[B]) *PipeSchema[A, B] {
+	return &PipeSchema[A, B]{First: first, Second: second}
+}
+
+func (s *PipeSchema[A, B]) Parse(value interface{}) *Result[B] {
+	return s.ParseState(value, nil)
+}
+
+func (s *PipeSchema[A, B]) ParseContext(ctx context.Context, value interface{}) *Result[B] {
+	return ParseContext[B](ctx, s, value)
+}
+
+func (s *PipeSchema[A, B]) ParseState(value interface{}, state *State) *Result[B] {
+	firstResult := ParseState(s.First, value, state)
+	if !firstResult.Ok {
+		return failedAs[B](firstResult)
+	}
+
+	return ParseState(s.Second, firstResult.Value, state)
+}
+
+// ParseTyped only parses the value with the second schema, as it is already
+// an output of the pipe.
+func (s *PipeSchema[A, B]) ParseTyped(value B) *Result[B] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *PipeSchema[A, B]) ParseTypedState(value B, state *State) *Result[B] {
+	return ParseTypedState(s.Second, value, state)
+}
+
+func (s *PipeSchema[A, B]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *PipeSchema[A, B]) Describe() *Description {
+	return Describe(s.First)
+}
+
+func (s *PipeSchema[A, B]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
@@ -0,0 +1,51 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/coercion"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeSchema_Parse(t *testing.T) {
+	schema := core.NewPipeSchema[string, int](
+		primitives.NewStringSchema("Age").Min(1),
+		coercion.NewCoerceNumberSchema[int]("Age").Gte(18),
+	)
+
+	result := schema.Parse("21")
+	assert.True(t, result.Ok)
+	assert.Equal(t, 21, result.Value)
+
+	result = schema.Parse("")
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+
+	result = schema.Parse("12")
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Must be greater than or equal to 18"}, result.Errors)
+
+	result = schema.Parse(21)
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+}
+
+func TestPipeSchema_ParseTyped(t *testing.T) {
+	schema := core.NewPipeSchema[string, int](
+		primitives.NewStringSchema("Age"),
+		coercion.NewCoerceNumberSchema[int]("Age").Gte(18),
+	)
+	assert.True(t, schema.ParseTyped(21).Ok)
+	assert.False(t, schema.ParseTyped(12).Ok)
+
+	trimmed := core.NewPipeSchema[string, string](
+		core.NewPreprocessSchema[string](trim, primitives.NewStringSchema("Name")),
+		primitives.NewStringSchema("Name").Max(5),
+	)
+	result := trimmed.ParseTyped("Abyan")
+	assert.True(t, result.Ok)
+	assert.Equal(t, "Abyan", result.Value)
+	assert.False(t, trimmed.ParseTyped("  Abyan  ").Ok)
+}
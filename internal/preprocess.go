@@ -0,0 +1,47 @@
+package core
+
+import "context"
+
+// PreprocessSchema rewrites the raw value before its inner schema type checks
+// and validates it, e.g., to trim strings or to unwrap envelopes.
+type PreprocessSchema[T any] struct {
+	Preprocess func(interface{}) interface{}
+	Inner      Parser[T]
+}
+
+func NewPreprocessSchema[T any](preprocess func(interface{}) interface{}, inner Parser[T]) *PreprocessSchema[T] {
+	return &PreprocessSchema[T]{Preprocess: preprocess, Inner: inner}
+}
+
+func (s *PreprocessSchema[T]) Parse(value interface{}) *Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *PreprocessSchema[T]) ParseContext(ctx context.Context, value interface{}) *Result[T] {
+	return ParseContext[T](ctx, s, value)
+}
+
+func (s *PreprocessSchema[T]) ParseState(value interface{}, state *State) *Result[T] {
+	return ParseState(s.Inner, s.Preprocess(value), state)
+}
+
+// ParseTyped skips preprocessing, which only rewrites raw input.
+func (s *PreprocessSchema[T]) ParseTyped(value T) *Result[T] {
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *PreprocessSchema[T]) ParseTypedState(value T, state *State) *Result[T] {
+	return ParseTypedState(s.Inner, value, state)
+}
+
+func (s *PreprocessSchema[T]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *PreprocessSchema[T]) Describe() *Description {
+	return Describe(s.Inner)
+}
+
+func (s *PreprocessSchema[T]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
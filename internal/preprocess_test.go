@@ -0,0 +1,37 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func trim(value interface{}) interface{} {
+	if valueStr, isString := value.(string); isString {
+		return strings.TrimSpace(valueStr)
+	}
+	return value
+}
+
+func TestPreprocessSchema(t *testing.T) {
+	schema := core.NewPreprocessSchema[string](trim, primitives.NewStringSchema("Name").Min(1))
+
+	result := schema.Parse("  Abyan  ")
+	assert.True(t, result.Ok)
+	assert.Equal(t, "Abyan", result.Value)
+
+	result = schema.ParseTyped("  Abyan  ")
+	assert.True(t, result.Ok)
+	assert.Equal(t, "  Abyan  ", result.Value)
+
+	result = schema.ParseTyped("")
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+
+	result = schema.Parse(42)
+	assert.False(t, result.Ok)
+	assert.Equal(t, core.InvalidType, result.Issues[0].Code)
+}
@@ -1,6 +1,10 @@
 package primitives
 
-import core "github.com/abyanmajid/v/internal"
+import (
+	"context"
+
+	core "github.com/abyanmajid/v/internal"
+)
 
 type AnySchema struct {
 	Schema *core.Schema[interface{}]
@@ -20,3 +24,31 @@ func (s *AnySchema) Parse(value interface{}) *core.Result[interface{}] {
 	result.Value = value
 	return result
 }
+
+func (s *AnySchema) ParseContext(ctx context.Context, value interface{}) *core.Result[interface{}] {
+	return core.ParseContext[interface{}](ctx, s, value)
+}
+
+func (s *AnySchema) ParseTyped(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s *AnySchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s *AnySchema) Describe() *core.Description {
+	return s.Schema.Describe(core.KindAny)
+}
+
+func (s *AnySchema) Default(value interface{}) *core.DefaultSchema[interface{}] {
+	return core.NewDefaultSchema[interface{}](s, func() interface{} { return value })
+}
+
+func (s *AnySchema) DefaultFunc(defaultValue func() interface{}) *core.DefaultSchema[interface{}] {
+	return core.NewDefaultSchema[interface{}](s, defaultValue)
+}
+
+func (s *AnySchema) Catch(fallback interface{}) *core.CatchSchema[interface{}] {
+	return core.NewCatchSchema[interface{}](s, fallback)
+}
@@ -25,3 +25,10 @@ func TestAnySchema_Parse(t *testing.T) {
 	assert.Equal(t, value, result.Value)
 	assert.True(t, result.Ok)
 }
+
+func TestAnySchema_ParseTyped(t *testing.T) {
+	schema := NewAnySchema("lol")
+
+	result := schema.ParseTyped("test value")
+	assert.True(t, result.Ok)
+}
@@ -1,6 +1,10 @@
 package primitives
 
-import core "github.com/abyanmajid/v/internal"
+import (
+	"context"
+
+	core "github.com/abyanmajid/v/internal"
+)
 
 type BooleanSchema struct {
 	Schema *core.Schema[bool]
@@ -16,14 +20,90 @@ func NewBooleanSchema(path string) *BooleanSchema {
 }
 
 func (s *BooleanSchema) Parse(value interface{}) *core.Result[bool] {
+	return s.ParseState(value, nil)
+}
+
+func (s *BooleanSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[bool] {
+	return core.ParseContext[bool](ctx, s, value)
+}
+
+func (s *BooleanSchema) ParseState(value interface{}, state *core.State) *core.Result[bool] {
 	valueBool, isBool := value.(bool)
 	if !isBool {
-		return s.Schema.NewErrorResult("Must be a boolean")
+		return s.Schema.NewTypeErrorResult(value, "boolean", "Must be a boolean")
 	}
 
-	return s.Schema.ParseGeneric(valueBool)
+	return s.Schema.ParseGenericState(valueBool, state)
 }
 
 func (s *BooleanSchema) ParseTyped(value bool) *core.Result[bool] {
-	return s.Schema.ParseGeneric(value)
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *BooleanSchema) ParseTypedState(value bool, state *core.State) *core.Result[bool] {
+	return s.Schema.ParseGenericState(value, state)
+}
+
+func (s *BooleanSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *BooleanSchema) Describe() *core.Description {
+	return s.Schema.Describe(core.KindBoolean)
+}
+
+func (s *BooleanSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *BooleanSchema) Default(value bool) *core.DefaultSchema[bool] {
+	return core.NewDefaultSchema[bool](s, func() bool { return value })
+}
+
+func (s *BooleanSchema) DefaultFunc(defaultValue func() bool) *core.DefaultSchema[bool] {
+	return core.NewDefaultSchema[bool](s, defaultValue)
+}
+
+func (s *BooleanSchema) Catch(fallback bool) *core.CatchSchema[bool] {
+	return core.NewCatchSchema[bool](s, fallback)
+}
+
+func (s *BooleanSchema) Refine(check func(bool) bool, message string, opts ...core.RefineOptions) *BooleanSchema {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *BooleanSchema) SuperRefine(refinement func(value bool, ctx *core.RefinementCtx)) *BooleanSchema {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *BooleanSchema) RefineContext(check func(ctx context.Context, value bool) error) *BooleanSchema {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *BooleanSchema) Messages(messageFunc core.MessageFunc) *BooleanSchema {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *BooleanSchema) FailFast() *BooleanSchema {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *BooleanSchema) Description(text string) *BooleanSchema {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *BooleanSchema) Example(value bool) *BooleanSchema {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *BooleanSchema) Then() *BooleanSchema {
+	s.Schema.Then()
+	return s
 }
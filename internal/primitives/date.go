@@ -1,6 +1,7 @@
 package primitives
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -21,34 +22,118 @@ func NewDateSchema(path string) *DateSchema {
 }
 
 func (s *DateSchema) Parse(value interface{}) *core.Result[time.Time] {
+	return s.ParseState(value, nil)
+}
+
+func (s *DateSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[time.Time] {
+	return core.ParseContext[time.Time](ctx, s, value)
+}
+
+func (s *DateSchema) ParseState(value interface{}, state *core.State) *core.Result[time.Time] {
 	valueTime, isTime := value.(time.Time)
 	if !isTime {
-		return s.Schema.NewErrorResult("Must be a string.")
+		return s.Schema.NewTypeErrorResult(value, "date", "Must be a string.")
 	}
 
-	return s.Schema.ParseGeneric(valueTime)
+	return s.Schema.ParseGenericState(valueTime, state)
 }
 
 func (s *DateSchema) ParseTyped(value time.Time) *core.Result[time.Time] {
-	return s.Schema.ParseGeneric(value)
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *DateSchema) ParseTypedState(value time.Time, state *core.State) *core.Result[time.Time] {
+	return s.Schema.ParseGenericState(value, state)
+}
+
+func (s *DateSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *DateSchema) Describe() *core.Description {
+	return s.Schema.Describe(core.KindDate)
+}
+
+func (s *DateSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *DateSchema) Default(value time.Time) *core.DefaultSchema[time.Time] {
+	return core.NewDefaultSchema[time.Time](s, func() time.Time { return value })
+}
+
+func (s *DateSchema) DefaultFunc(defaultValue func() time.Time) *core.DefaultSchema[time.Time] {
+	return core.NewDefaultSchema[time.Time](s, defaultValue)
+}
+
+func (s *DateSchema) Catch(fallback time.Time) *core.CatchSchema[time.Time] {
+	return core.NewCatchSchema[time.Time](s, fallback)
+}
+
+func (s *DateSchema) Refine(check func(time.Time) bool, message string, opts ...core.RefineOptions) *DateSchema {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *DateSchema) SuperRefine(refinement func(value time.Time, ctx *core.RefinementCtx)) *DateSchema {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *DateSchema) RefineContext(check func(ctx context.Context, value time.Time) error) *DateSchema {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *DateSchema) Messages(messageFunc core.MessageFunc) *DateSchema {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *DateSchema) FailFast() *DateSchema {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *DateSchema) Description(text string) *DateSchema {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *DateSchema) Example(value time.Time) *DateSchema {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *DateSchema) Then() *DateSchema {
+	s.Schema.Then()
+	return s
 }
 
-func (s *DateSchema) Min(earliest time.Time) *DateSchema {
-	s.Schema.AddRule(func(value time.Time) *core.Result[time.Time] {
+func (s *DateSchema) Min(earliest time.Time, message ...string) *DateSchema {
+	params := core.Params{"type": "date", "min": earliest, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value time.Time) *core.Result[time.Time] {
 		if value.Before(earliest) {
-			errorMessage := fmt.Sprintf("Must be later than or equal to %v", earliest)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: fmt.Sprintf("Must be later than or equal to %v", earliest),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *DateSchema) Max(latest time.Time) *DateSchema {
-	s.Schema.AddRule(func(value time.Time) *core.Result[time.Time] {
+func (s *DateSchema) Max(latest time.Time, message ...string) *DateSchema {
+	params := core.Params{"type": "date", "max": latest, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value time.Time) *core.Result[time.Time] {
 		if value.After(latest) {
-			errorMessage := fmt.Sprintf("Must be earlier than or equal to %v", latest)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: fmt.Sprintf("Must be earlier than or equal to %v", latest),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
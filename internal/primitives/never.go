@@ -1,6 +1,10 @@
 package primitives
 
-import core "github.com/abyanmajid/v/internal"
+import (
+	"context"
+
+	core "github.com/abyanmajid/v/internal"
+)
 
 type NeverSchema struct {
 	Schema *core.Schema[interface{}]
@@ -16,5 +20,37 @@ func NewNeverSchema(path string) *NeverSchema {
 }
 
 func (s *NeverSchema) Parse(value interface{}) *core.Result[interface{}] {
-	return s.Schema.NewErrorResult("Value is not allowed.")
+	return s.Schema.NewIssueResult(value, core.Issue{
+		Code:     core.NotAllowed,
+		Received: core.TypeName(value),
+		Message:  "Value is not allowed.",
+	})
+}
+
+func (s *NeverSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[interface{}] {
+	return core.ParseContext[interface{}](ctx, s, value)
+}
+
+func (s *NeverSchema) ParseTyped(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s *NeverSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s *NeverSchema) Describe() *core.Description {
+	return s.Schema.Describe(core.KindNever)
+}
+
+func (s *NeverSchema) Default(value interface{}) *core.DefaultSchema[interface{}] {
+	return core.NewDefaultSchema[interface{}](s, func() interface{} { return value })
+}
+
+func (s *NeverSchema) DefaultFunc(defaultValue func() interface{}) *core.DefaultSchema[interface{}] {
+	return core.NewDefaultSchema[interface{}](s, defaultValue)
+}
+
+func (s *NeverSchema) Catch(fallback interface{}) *core.CatchSchema[interface{}] {
+	return core.NewCatchSchema[interface{}](s, fallback)
 }
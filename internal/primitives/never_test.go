@@ -23,3 +23,10 @@ func TestNeverSchema_Parse(t *testing.T) {
 	assert.False(t, result.Ok)
 	assert.Contains(t, result.Errors, "Value is not allowed.")
 }
+
+func TestNeverSchema_ParseTyped(t *testing.T) {
+	schema := NewNeverSchema("lol")
+
+	result := schema.ParseTyped("test value")
+	assert.False(t, result.Ok)
+}
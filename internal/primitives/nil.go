@@ -1,6 +1,10 @@
 package primitives
 
-import core "github.com/abyanmajid/v/internal"
+import (
+	"context"
+
+	core "github.com/abyanmajid/v/internal"
+)
 
 type NilSchema struct {
 	Schema *core.Schema[interface{}]
@@ -17,8 +21,36 @@ func NewNilSchema(path string) *NilSchema {
 
 func (s *NilSchema) Parse(value interface{}) *core.Result[interface{}] {
 	if value != nil {
-		return s.Schema.NewErrorResult("Value must be nil.")
+		return s.Schema.NewTypeErrorResult(value, "nil", "Value must be nil.")
 	}
 
 	return s.Schema.NewSuccessResult()
 }
+
+func (s *NilSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[interface{}] {
+	return core.ParseContext[interface{}](ctx, s, value)
+}
+
+func (s *NilSchema) ParseTyped(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s *NilSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value)
+}
+
+func (s *NilSchema) Describe() *core.Description {
+	return s.Schema.Describe(core.KindNil)
+}
+
+func (s *NilSchema) Default(value interface{}) *core.DefaultSchema[interface{}] {
+	return core.NewDefaultSchema[interface{}](s, func() interface{} { return value })
+}
+
+func (s *NilSchema) DefaultFunc(defaultValue func() interface{}) *core.DefaultSchema[interface{}] {
+	return core.NewDefaultSchema[interface{}](s, defaultValue)
+}
+
+func (s *NilSchema) Catch(fallback interface{}) *core.CatchSchema[interface{}] {
+	return core.NewCatchSchema[interface{}](s, fallback)
+}
@@ -31,3 +31,10 @@ func TestNilSchema_Parse(t *testing.T) {
 		assert.Equal(t, "Value must be nil.", result.Errors[0])
 	})
 }
+
+func TestNilSchema_ParseTyped(t *testing.T) {
+	schema := NewNilSchema("lol")
+
+	result := schema.ParseTyped(nil)
+	assert.True(t, result.Ok)
+}
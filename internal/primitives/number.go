@@ -1,6 +1,7 @@
 package primitives
 
 import (
+	"context"
 	"fmt"
 	"math"
 
@@ -8,7 +9,7 @@ import (
 )
 
 type Number interface {
-	~float64 | ~float32 | ~int | ~int32 | ~int64
+	~float64 | ~float32 | ~int | ~int32 | ~int64 | ~uint | ~uint32 | ~uint64
 }
 
 type NumberSchema[T Number] struct {
@@ -25,119 +26,248 @@ func NewNumberSchema[T Number](path string) *NumberSchema[T] {
 }
 
 func (s *NumberSchema[T]) Parse(value interface{}) *core.Result[T] {
+	return s.ParseState(value, nil)
+}
+
+func (s *NumberSchema[T]) ParseContext(ctx context.Context, value interface{}) *core.Result[T] {
+	return core.ParseContext[T](ctx, s, value)
+}
+
+func (s *NumberSchema[T]) ParseState(value interface{}, state *core.State) *core.Result[T] {
 	valueT, isT := value.(T)
 	if !isT {
-		return s.Schema.NewErrorResult("Must be a number.")
+		return s.Schema.NewTypeErrorResult(value, "number", "Must be a number.")
 	}
 
-	return s.Schema.ParseGeneric(valueT)
+	return s.Schema.ParseGenericState(valueT, state)
 }
 
 func (s *NumberSchema[T]) ParseTyped(value T) *core.Result[T] {
-	return s.Schema.ParseGeneric(value)
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *NumberSchema[T]) ParseTypedState(value T, state *core.State) *core.Result[T] {
+	return s.Schema.ParseGenericState(value, state)
+}
+
+func (s *NumberSchema[T]) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *NumberSchema[T]) Describe() *core.Description {
+	return s.Schema.Describe(core.KindOf[T]())
+}
+
+func (s *NumberSchema[T]) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *NumberSchema[T]) Default(value T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, func() T { return value })
+}
+
+func (s *NumberSchema[T]) DefaultFunc(defaultValue func() T) *core.DefaultSchema[T] {
+	return core.NewDefaultSchema[T](s, defaultValue)
+}
+
+func (s *NumberSchema[T]) Catch(fallback T) *core.CatchSchema[T] {
+	return core.NewCatchSchema[T](s, fallback)
+}
+
+func (s *NumberSchema[T]) Refine(check func(T) bool, message string, opts ...core.RefineOptions) *NumberSchema[T] {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *NumberSchema[T]) SuperRefine(refinement func(value T, ctx *core.RefinementCtx)) *NumberSchema[T] {
+	s.Schema.SuperRefine(refinement)
+	return s
 }
 
-func (s *NumberSchema[T]) Gt(lowerBound T) *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) RefineContext(check func(ctx context.Context, value T) error) *NumberSchema[T] {
+	s.Schema.RefineContext(check)
+	return s
+}
+
+func (s *NumberSchema[T]) Messages(messageFunc core.MessageFunc) *NumberSchema[T] {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *NumberSchema[T]) FailFast() *NumberSchema[T] {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *NumberSchema[T]) Description(text string) *NumberSchema[T] {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *NumberSchema[T]) Example(value T) *NumberSchema[T] {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *NumberSchema[T]) Then() *NumberSchema[T] {
+	s.Schema.Then()
+	return s
+}
+
+func (s *NumberSchema[T]) Gt(lowerBound T, message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "min": lowerBound, "inclusive": false}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value T) *core.Result[T] {
 		if value <= lowerBound {
-			errorMessage := fmt.Sprintf("Must be greater than %v", lowerBound)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: fmt.Sprintf("Must be greater than %v", lowerBound),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) Gte(lowerBound T) *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) Gte(lowerBound T, message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "min": lowerBound, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value T) *core.Result[T] {
 		if value < lowerBound {
-			errorMessage := fmt.Sprintf("Must be greater than or equal to %v", lowerBound)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: fmt.Sprintf("Must be greater than or equal to %v", lowerBound),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) Lt(upperBound T) *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) Lt(upperBound T, message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "max": upperBound, "inclusive": false}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value T) *core.Result[T] {
 		if value >= upperBound {
-			errorMessage := fmt.Sprintf("Must be smaller than %v", upperBound)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: fmt.Sprintf("Must be smaller than %v", upperBound),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) Lte(upperBound T) *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) Lte(upperBound T, message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "max": upperBound, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value T) *core.Result[T] {
 		if value > upperBound {
-			errorMessage := fmt.Sprintf("Must be smaller than or equal to %v", upperBound)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: fmt.Sprintf("Must be smaller than or equal to %v", upperBound),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) Positive() *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) Positive(message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "min": 0, "inclusive": false}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value T) *core.Result[T] {
 		if value <= 0 {
-			return s.Schema.NewErrorResult("Must be a positive number")
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: "Must be a positive number",
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) NonNegative() *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) NonNegative(message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "min": 0, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value T) *core.Result[T] {
 		if value < 0 {
-			return s.Schema.NewErrorResult("Must be a non-negative number")
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: "Must be a non-negative number",
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) Negative() *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) Negative(message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "max": 0, "inclusive": false}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value T) *core.Result[T] {
 		if value >= 0 {
-			return s.Schema.NewErrorResult("Must be a negative number")
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: "Must be a negative number",
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) NonPositive() *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) NonPositive(message ...string) *NumberSchema[T] {
+	params := core.Params{"type": "number", "max": 0, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value T) *core.Result[T] {
 		if value > 0 {
-			return s.Schema.NewErrorResult("Must be a non-positive number")
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: "Must be a non-positive number",
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) MultipleOf(step T) *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) MultipleOf(step T, message ...string) *NumberSchema[T] {
+	params := core.Params{"multiple_of": step}
+	s.Schema.AddCheck(core.Check{Code: core.NotMultipleOf, Params: params}, func(value T) *core.Result[T] {
 		if math.Mod(float64(value), float64(step)) != 0 {
-			errorMessage := fmt.Sprintf("Must be a multiple of %v", step)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.NotMultipleOf,
+				Params:  params,
+				Message: fmt.Sprintf("Must be a multiple of %v", step),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *NumberSchema[T]) Finite() *NumberSchema[T] {
-	s.Schema.AddRule(func(value T) *core.Result[T] {
+func (s *NumberSchema[T]) Finite(message ...string) *NumberSchema[T] {
+	s.Schema.AddCheck(core.Check{Code: core.NotFinite}, func(value T) *core.Result[T] {
 		if math.IsInf(float64(value), 0) {
-			return s.Schema.NewErrorResult("Must be a finite number")
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.NotFinite,
+				Message: "Must be a finite number",
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
+
+func (s *NumberSchema[T]) Exists(lookup core.Lookup[T], message ...string) *NumberSchema[T] {
+	s.Schema.Exists(lookup, message...)
+	return s
+}
+
+func (s *NumberSchema[T]) NotExists(lookup core.Lookup[T], message ...string) *NumberSchema[T] {
+	s.Schema.NotExists(lookup, message...)
+	return s
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"testing"
 
+	core "github.com/abyanmajid/v/internal"
 	"github.com/abyanmajid/v/internal/primitives"
 	"github.com/stretchr/testify/assert"
 )
@@ -142,3 +143,15 @@ func TestNumberSchema_Finite(t *testing.T) {
 	assert.False(t, result.Ok)
 	assert.Equal(t, "Must be a finite number", result.Errors[0])
 }
+
+func TestNumberSchema_Issues(t *testing.T) {
+	schema := primitives.NewNumberSchema[int]("abyan has a majestic cat").Gt(10).Lte(5).MultipleOf(4)
+
+	result := schema.ParseTyped(7)
+	assert.False(t, result.Ok)
+	assert.Equal(t, []core.Issue{
+		{Code: core.TooSmall, Params: core.Params{"type": "number", "min": 10, "inclusive": false}, Received: "int", Message: "Must be greater than 10"},
+		{Code: core.TooBig, Params: core.Params{"type": "number", "max": 5, "inclusive": true}, Received: "int", Message: "Must be smaller than or equal to 5"},
+		{Code: core.NotMultipleOf, Params: core.Params{"multiple_of": 4}, Received: "int", Message: "Must be a multiple of 4"},
+	}, result.Issues)
+}
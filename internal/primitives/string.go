@@ -1,6 +1,7 @@
 package primitives
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/url"
@@ -25,210 +26,331 @@ func NewStringSchema(path string) *StringSchema {
 }
 
 func (s *StringSchema) Parse(value interface{}) *core.Result[string] {
+	return s.ParseState(value, nil)
+}
+
+func (s *StringSchema) ParseContext(ctx context.Context, value interface{}) *core.Result[string] {
+	return core.ParseContext[string](ctx, s, value)
+}
+
+func (s *StringSchema) ParseState(value interface{}, state *core.State) *core.Result[string] {
 	valueStr, isString := value.(string)
 	if !isString {
-		return s.Schema.NewErrorResult("Must be a string.")
+		return s.Schema.NewTypeErrorResult(value, "string", "Must be a string.")
 	}
 
-	return s.Schema.ParseGeneric(valueStr)
+	return s.Schema.ParseGenericState(valueStr, state)
 }
 
 func (s *StringSchema) ParseTyped(value string) *core.Result[string] {
-	return s.Schema.ParseGeneric(value)
+	return s.ParseTypedState(value, nil)
+}
+
+func (s *StringSchema) ParseTypedState(value string, state *core.State) *core.Result[string] {
+	return s.Schema.ParseGenericState(value, state)
+}
+
+func (s *StringSchema) ParseAny(value interface{}) *core.Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *StringSchema) Describe() *core.Description {
+	return s.Schema.Describe(core.KindString)
+}
+
+func (s *StringSchema) ParseAnyState(value interface{}, state *core.State) *core.Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *StringSchema) Default(value string) *core.DefaultSchema[string] {
+	return core.NewDefaultSchema[string](s, func() string { return value })
+}
+
+func (s *StringSchema) DefaultFunc(defaultValue func() string) *core.DefaultSchema[string] {
+	return core.NewDefaultSchema[string](s, defaultValue)
+}
+
+func (s *StringSchema) Catch(fallback string) *core.CatchSchema[string] {
+	return core.NewCatchSchema[string](s, fallback)
+}
+
+func (s *StringSchema) Refine(check func(string) bool, message string, opts ...core.RefineOptions) *StringSchema {
+	s.Schema.Refine(check, message, opts...)
+	return s
+}
+
+func (s *StringSchema) SuperRefine(refinement func(value string, ctx *core.RefinementCtx)) *StringSchema {
+	s.Schema.SuperRefine(refinement)
+	return s
+}
+
+func (s *StringSchema) RefineContext(check func(ctx context.Context, value string) error) *StringSchema {
+	s.Schema.RefineContext(check)
+	return s
 }
 
-func (s *StringSchema) Min(minLength int) *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Messages(messageFunc core.MessageFunc) *StringSchema {
+	s.Schema.MessageFunc = messageFunc
+	return s
+}
+
+func (s *StringSchema) FailFast() *StringSchema {
+	s.Schema.FailFast = true
+	return s
+}
+
+func (s *StringSchema) Description(text string) *StringSchema {
+	s.Schema.Doc = text
+	return s
+}
+
+func (s *StringSchema) Example(value string) *StringSchema {
+	s.Schema.Examples = append(s.Schema.Examples, value)
+	return s
+}
+
+func (s *StringSchema) Then() *StringSchema {
+	s.Schema.Then()
+	return s
+}
+
+func (s *StringSchema) Exists(lookup core.Lookup[string], message ...string) *StringSchema {
+	s.Schema.Exists(lookup, message...)
+	return s
+}
+
+func (s *StringSchema) NotExists(lookup core.Lookup[string], message ...string) *StringSchema {
+	s.Schema.NotExists(lookup, message...)
+	return s
+}
+
+func (s *StringSchema) Min(minLength int, message ...string) *StringSchema {
+	params := core.Params{"type": "string", "min": minLength, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooSmall, Params: params}, func(value string) *core.Result[string] {
 		if len(value) < minLength {
-			errorMessage := fmt.Sprintf("Must be longer than %d characters in length", minLength)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooSmall,
+				Params:  params,
+				Message: fmt.Sprintf("Must be at least %d characters long", minLength),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) Max(maxLength int) *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Max(maxLength int, message ...string) *StringSchema {
+	params := core.Params{"type": "string", "max": maxLength, "inclusive": true}
+	s.Schema.AddCheck(core.Check{Code: core.TooBig, Params: params}, func(value string) *core.Result[string] {
 		if len(value) > maxLength {
-			errorMessage := fmt.Sprintf("Must be shorter than %d characters in length", maxLength)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.TooBig,
+				Params:  params,
+				Message: fmt.Sprintf("Must be at most %d characters long", maxLength),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) Length(length int) *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Length(length int, message ...string) *StringSchema {
+	params := core.Params{"type": "string", "length": length}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidLength, Params: params}, func(value string) *core.Result[string] {
 		if len(value) != length {
-			errorMessage := fmt.Sprintf("Must be exactly %d characters long", length)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.Schema.NewIssueResult(value, core.Issue{
+				Code:    core.InvalidLength,
+				Params:  params,
+				Message: fmt.Sprintf("Must be exactly %d characters long", length),
+			}, message...)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) Email() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Email(message ...string) *StringSchema {
+	params := core.Params{"validation": "email"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		emailRegex := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
 		if !regexp.MustCompile(emailRegex).MatchString(value) {
-			return s.Schema.NewErrorResult("Must be a valid email address")
+			return s.newInvalidStringResult(value, params, "Must be a valid email address", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) URL() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) URL(message ...string) *StringSchema {
+	params := core.Params{"validation": "url"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		_, err := url.ParseRequestURI(value)
 		if err != nil {
-			return s.Schema.NewErrorResult("Must be a valid URL")
+			return s.newInvalidStringResult(value, params, "Must be a valid URL", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) Regex(regex *regexp.Regexp) *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Regex(regex *regexp.Regexp, message ...string) *StringSchema {
+	params := core.Params{"validation": "regex", "pattern": regex.String()}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		if !regex.MatchString(value) {
-			return s.Schema.NewErrorResult("Must match the required pattern")
+			return s.newInvalidStringResult(value, params, "Must match the required pattern", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) Includes(substr string) *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Includes(substr string, message ...string) *StringSchema {
+	params := core.Params{"validation": "includes", "includes": substr}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		if !strings.Contains(value, substr) {
 			errorMessage := fmt.Sprintf("Must include '%s'", substr)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.newInvalidStringResult(value, params, errorMessage, message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) StartsWith(prefix string) *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) StartsWith(prefix string, message ...string) *StringSchema {
+	params := core.Params{"validation": "starts_with", "starts_with": prefix}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		if !strings.HasPrefix(value, prefix) {
 			errorMessage := fmt.Sprintf("Must start with '%s'", prefix)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.newInvalidStringResult(value, params, errorMessage, message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) EndsWith(suffix string) *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) EndsWith(suffix string, message ...string) *StringSchema {
+	params := core.Params{"validation": "ends_with", "ends_with": suffix}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		if !strings.HasSuffix(value, suffix) {
 			errorMessage := fmt.Sprintf("Must end with '%s'", suffix)
-			return s.Schema.NewErrorResult(errorMessage)
+			return s.newInvalidStringResult(value, params, errorMessage, message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) Date() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Date(message ...string) *StringSchema {
+	params := core.Params{"validation": "date"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		_, err := time.Parse("2006-01-02", value)
 		if err != nil {
-			return s.Schema.NewErrorResult("Must follow a valid date format")
+			return s.newInvalidStringResult(value, params, "Must follow a valid date format", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) Time() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) Time(message ...string) *StringSchema {
+	params := core.Params{"validation": "time"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		_, err := time.Parse("15:04:05", value)
 		if err != nil {
-			return s.Schema.NewErrorResult("Must follow a valid time format")
+			return s.newInvalidStringResult(value, params, "Must follow a valid time format", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) IP() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) IP(message ...string) *StringSchema {
+	params := core.Params{"validation": "ip"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		if net.ParseIP(value) == nil {
-			return s.Schema.NewErrorResult("Must be a valid IP address")
+			return s.newInvalidStringResult(value, params, "Must be a valid IP address", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) CIDR() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) CIDR(message ...string) *StringSchema {
+	params := core.Params{"validation": "cidr"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		_, _, err := net.ParseCIDR(value)
 		if err != nil {
-			return s.Schema.NewErrorResult("Must be of valid CIDR notation")
+			return s.newInvalidStringResult(value, params, "Must be of valid CIDR notation", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) UUID() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) UUID(message ...string) *StringSchema {
+	params := core.Params{"validation": "uuid"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		uuidRegex := `^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`
 		if !regexp.MustCompile(uuidRegex).MatchString(value) {
-			return s.Schema.NewErrorResult("Must be a valid UUID")
+			return s.newInvalidStringResult(value, params, "Must be a valid UUID", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) NanoID() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) NanoID(message ...string) *StringSchema {
+	params := core.Params{"validation": "nanoid"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		nanoidRegex := `^[a-zA-Z0-9_-]{21}$`
 		if !regexp.MustCompile(nanoidRegex).MatchString(value) {
-			return s.Schema.NewErrorResult("Must be a valid NanoID")
+			return s.newInvalidStringResult(value, params, "Must be a valid NanoID", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) CUID() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) CUID(message ...string) *StringSchema {
+	params := core.Params{"validation": "cuid"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		cuidRegex := `^c[0-9a-z]{24}$`
 		if !regexp.MustCompile(cuidRegex).MatchString(value) {
-			return s.Schema.NewErrorResult("Must be a valid CUID")
+			return s.newInvalidStringResult(value, params, "Must be a valid CUID", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) CUID2() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) CUID2(message ...string) *StringSchema {
+	params := core.Params{"validation": "cuid2"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		cuid2Regex := `^[a-z][a-z0-9]*$`
 		if !regexp.MustCompile(cuid2Regex).MatchString(value) {
-			return s.Schema.NewErrorResult("Must be a valid CUID2")
+			return s.newInvalidStringResult(value, params, "Must be a valid CUID2", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
 
-func (s *StringSchema) ULID() *StringSchema {
-	s.Schema.AddRule(func(value string) *core.Result[string] {
+func (s *StringSchema) ULID(message ...string) *StringSchema {
+	params := core.Params{"validation": "ulid"}
+	s.Schema.AddCheck(core.Check{Code: core.InvalidString, Params: params}, func(value string) *core.Result[string] {
 		ulidRegex := `^[0-9A-HJKMNP-TV-Z]{26}$`
 		if !regexp.MustCompile(ulidRegex).MatchString(value) {
-			return s.Schema.NewErrorResult("Must be a valid ULID")
+			return s.newInvalidStringResult(value, params, "Must be a valid ULID", message)
 		}
 		return s.Schema.NewSuccessResult()
 	})
 	return s
 }
+
+func (s *StringSchema) newInvalidStringResult(value string, params core.Params, errorMessage string, message []string) *core.Result[string] {
+	return s.Schema.NewIssueResult(value, core.Issue{
+		Code:    core.InvalidString,
+		Params:  params,
+		Message: errorMessage,
+	}, message...)
+}
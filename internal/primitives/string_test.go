@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"testing"
 
+	core "github.com/abyanmajid/v/internal"
 	"github.com/abyanmajid/v/internal/primitives"
 	"github.com/stretchr/testify/assert"
 )
@@ -28,7 +29,7 @@ func TestStringSchema_Min(t *testing.T) {
 	schema := primitives.NewStringSchema("abyan has a majestic cat").Min(5)
 	result := schema.Parse("test")
 	assert.False(t, result.Ok)
-	assert.Contains(t, result.Errors, "Must be longer than 5 characters in length")
+	assert.Contains(t, result.Errors, "Must be at least 5 characters long")
 
 	result = schema.Parse("testing")
 	assert.True(t, result.Ok)
@@ -38,7 +39,7 @@ func TestStringSchema_Max(t *testing.T) {
 	schema := primitives.NewStringSchema("abyan has a majestic cat").Max(5)
 	result := schema.Parse("testing")
 	assert.False(t, result.Ok)
-	assert.Contains(t, result.Errors, "Must be shorter than 5 characters in length")
+	assert.Contains(t, result.Errors, "Must be at most 5 characters long")
 
 	result = schema.Parse("test")
 	assert.True(t, result.Ok)
@@ -203,3 +204,68 @@ func TestStringSchema_ULID(t *testing.T) {
 	assert.False(t, result.Ok)
 	assert.Contains(t, result.Errors, "Must be a valid ULID")
 }
+
+func TestStringSchema_Issues(t *testing.T) {
+	schema := primitives.NewStringSchema("abyan has a majestic cat").Min(5).Email().StartsWith("abyan")
+
+	result := schema.Parse("cat")
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 3)
+
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+	assert.Equal(t, core.Params{"type": "string", "min": 5, "inclusive": true}, result.Issues[0].Params)
+	assert.Equal(t, "string", result.Issues[0].Received)
+	assert.Equal(t, result.Errors[0], result.Issues[0].Message)
+
+	assert.Equal(t, core.InvalidString, result.Issues[1].Code)
+	assert.Equal(t, "email", result.Issues[1].Params["validation"])
+
+	assert.Equal(t, core.InvalidString, result.Issues[2].Code)
+	assert.Equal(t, core.Params{"validation": "starts_with", "starts_with": "abyan"}, result.Issues[2].Params)
+
+	result = schema.Parse(42)
+	assert.Equal(t, []core.Issue{{
+		Code:     core.InvalidType,
+		Params:   core.Params{"expected": "string"},
+		Received: "int",
+		Message:  "Must be a string.",
+	}}, result.Issues)
+}
+
+func TestStringSchema_FailFast(t *testing.T) {
+	schema := primitives.NewStringSchema("email").Min(5).Email().FailFast()
+
+	result := schema.Parse("cat")
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+
+	result = schema.Parse("abyan@majestic")
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, "email", result.Issues[0].Params["validation"])
+}
+
+func TestStringSchema_Then(t *testing.T) {
+	schema := primitives.NewStringSchema("email").Min(1).Then().Email().EndsWith(".com")
+
+	result := schema.Parse("")
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, core.TooSmall, result.Issues[0].Code)
+
+	result = schema.Parse("cat")
+	assert.Len(t, result.Issues, 2)
+
+	assert.True(t, schema.Parse("abyan@majestic.com").Ok)
+}
+
+func TestStringSchema_AbortEarly(t *testing.T) {
+	schema := primitives.NewStringSchema("email").Min(5).Email()
+
+	result := core.ParseWith[string](schema, "cat", core.ParseOptions{AbortEarly: true})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 1)
+
+	result = core.ParseWith[string](schema, "cat", core.ParseOptions{})
+	assert.Len(t, result.Issues, 2)
+}
@@ -0,0 +1,51 @@
+package core
+
+type RefineOptions struct {
+	Code   string
+	Path   Path
+	Params Params
+}
+
+// RefinementCtx collects the issues reported by a SuperRefine function.
+type RefinementCtx struct {
+	Issues []Issue
+}
+
+func (c *RefinementCtx) AddIssue(issue Issue) {
+	if issue.Code == "" {
+		issue.Code = Custom
+	}
+	c.Issues = append(c.Issues, issue)
+}
+
+// Refine adds a rule that fails with the given message whenever check returns
+// false. The code, path and params of the issue can be set through opts.
+func (s *Schema[T]) Refine(check func(T) bool, message string, opts ...RefineOptions) {
+	s.AddRule(func(value T) *Result[T] {
+		if check(value) {
+			return s.NewSuccessResult()
+		}
+
+		issue := Issue{Code: Custom}
+		for _, opt := range opts {
+			if opt.Code != "" {
+				issue.Code = opt.Code
+			}
+			issue.Path = opt.Path
+			issue.Params = opt.Params
+		}
+		return s.NewIssueResult(value, issue, message)
+	})
+}
+
+// SuperRefine adds a rule that may report any number of issues through ctx.
+func (s *Schema[T]) SuperRefine(refinement func(value T, ctx *RefinementCtx)) {
+	s.AddRule(func(value T) *Result[T] {
+		ctx := &RefinementCtx{}
+		refinement(value, ctx)
+
+		result := s.NewSuccessResult()
+		result.AddIssues(ctx.Issues...)
+		return result
+	})
+}
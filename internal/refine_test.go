@@ -0,0 +1,77 @@
+package core_test
+
+import (
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_Refine(t *testing.T) {
+	schema := primitives.NewStringSchema("Username").Refine(func(value string) bool {
+		return value != "admin"
+	}, "Username is reserved")
+
+	assert.True(t, schema.Parse("abyan").Ok)
+
+	result := schema.Parse("admin")
+	assert.False(t, result.Ok)
+	assert.Equal(t, []string{"Username is reserved"}, result.Errors)
+	assert.Equal(t, core.Custom, result.Issues[0].Code)
+	assert.Equal(t, "string", result.Issues[0].Received)
+}
+
+func TestSchema_RefineOptions(t *testing.T) {
+	schema := primitives.NewNumberSchema[int]("Port").Refine(func(value int) bool {
+		return value != 22
+	}, "Port is reserved", core.RefineOptions{Code: "reserved_port", Params: core.Params{"port": 22}})
+
+	result := schema.Parse(22)
+	assert.False(t, result.Ok)
+	assert.Equal(t, "reserved_port", result.Issues[0].Code)
+	assert.Equal(t, core.Params{"port": 22}, result.Issues[0].Params)
+}
+
+func TestSchema_SuperRefine(t *testing.T) {
+	schema := composites.NewObjectSchema("Applicant", composites.Shape{
+		"wam":           primitives.NewNumberSchema[float64]("wam"),
+		"has_graduated": primitives.NewBooleanSchema("has_graduated"),
+		"password":      primitives.NewStringSchema("password"),
+		"confirm":       primitives.NewStringSchema("confirm"),
+	}).SuperRefine(func(value map[string]interface{}, ctx *core.RefinementCtx) {
+		if value["has_graduated"] == true && value["wam"].(float64) < 65 {
+			ctx.AddIssue(core.Issue{
+				Code:    "wam_too_low",
+				Path:    core.Path{"wam"},
+				Message: "WAM must be at least 65 for graduates",
+			})
+		}
+		if value["password"] != value["confirm"] {
+			ctx.AddIssue(core.Issue{Path: core.Path{"confirm"}, Message: "Passwords do not match"})
+		}
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"wam":           70.0,
+		"has_graduated": true,
+		"password":      "secret",
+		"confirm":       "secret",
+	})
+	assert.True(t, result.Ok)
+
+	result = schema.Parse(map[string]interface{}{
+		"wam":           60.0,
+		"has_graduated": true,
+		"password":      "secret",
+		"confirm":       "secrets",
+	})
+	assert.False(t, result.Ok)
+	assert.Len(t, result.Issues, 2)
+	assert.Equal(t, "wam_too_low", result.Issues[0].Code)
+	assert.Equal(t, "wam", result.Issues[0].Path.String())
+	assert.Equal(t, core.Custom, result.Issues[1].Code)
+	assert.Equal(t, "/confirm", result.Issues[1].Path.Pointer())
+	assert.Equal(t, "confirm: Passwords do not match", result.Errors[1])
+}
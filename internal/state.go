@@ -0,0 +1,158 @@
+package core
+
+import (
+	"context"
+	"reflect"
+)
+
+// State carries what a parse knows about the values enclosing the one being
+// parsed. States are immutable: entering a value derives a new state, so one
+// state may be shared by sibling values. A nil state is the root state.
+type State struct {
+	parent  *State
+	node    node
+	depth   int
+	options ParseOptions
+	ctx     context.Context
+}
+
+// ParseOptions change how a whole parse runs, including the schemas nested
+// in the one it starts from.
+type ParseOptions struct {
+	// AbortEarly stops the parse at the first failing rule or element, so
+	// untrusted input is not validated further than needed to reject it.
+	AbortEarly bool
+}
+
+func NewState(options ParseOptions) *State {
+	return &State{options: options}
+}
+
+type node struct {
+	schema    interface{}
+	valueType reflect.Type
+	pointer   uintptr
+	length    int
+}
+
+// StateParser is implemented by schemas that pass the state of a parse on to
+// the schemas they are made of.
+type StateParser[T any] interface {
+	ParseState(value interface{}, state *State) *Result[T]
+}
+
+// TypedStateParser is the StateParser counterpart of ParseTyped.
+type TypedStateParser[T any] interface {
+	ParseTypedState(value T, state *State) *Result[T]
+}
+
+type AnyStateParser interface {
+	ParseAnyState(value interface{}, state *State) *Result[interface{}]
+}
+
+func ParseState[T any](parser Parser[T], value interface{}, state *State) *Result[T] {
+	if stateParser, isStateParser := parser.(StateParser[T]); isStateParser {
+		return stateParser.ParseState(value, state)
+	}
+	return parser.Parse(value)
+}
+
+func ParseTypedState[T any](parser Parser[T], value T, state *State) *Result[T] {
+	if stateParser, isStateParser := parser.(TypedStateParser[T]); isStateParser {
+		return stateParser.ParseTypedState(value, state)
+	}
+	return parser.ParseTyped(value)
+}
+
+func ParseAnyState(parser AnyParser, value interface{}, state *State) *Result[interface{}] {
+	if stateParser, isStateParser := parser.(AnyStateParser); isStateParser {
+		return stateParser.ParseAnyState(value, state)
+	}
+	return parser.ParseAny(value)
+}
+
+// ParseContext parses the value like Parse, passing ctx on to the context
+// rules of the schema and of the schemas nested in it.
+func ParseContext[T any](ctx context.Context, parser Parser[T], value interface{}) *Result[T] {
+	return ParseState(parser, value, NewState(ParseOptions{}).WithContext(ctx))
+}
+
+// ParseWith parses the value like Parse, with the given options.
+func ParseWith[T any](parser Parser[T], value interface{}, options ParseOptions) *Result[T] {
+	return ParseState(parser, value, NewState(options))
+}
+
+func (s *State) Options() ParseOptions {
+	if s == nil {
+		return ParseOptions{}
+	}
+	return s.options
+}
+
+// WithContext derives a state whose context rules run with ctx.
+func (s *State) WithContext(ctx context.Context) *State {
+	derived := &State{}
+	if s != nil {
+		*derived = *s
+	}
+	derived.ctx = ctx
+	return derived
+}
+
+// Context returns the context of the parse, which is context.Background for
+// parses that were not given one.
+func (s *State) Context() context.Context {
+	if s == nil || s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+func (s *State) AbortEarly() bool {
+	return s.Options().AbortEarly
+}
+
+func (s *State) Depth() int {
+	if s == nil {
+		return 0
+	}
+	return s.depth
+}
+
+// Enter derives the state of a nested value, reporting false when the value
+// is a map, slice or pointer that is already being parsed further up, which
+// means the value contains a cycle.
+func (s *State) Enter(value interface{}) (*State, bool) {
+	return s.EnterFrom(nil, value)
+}
+
+// EnterFrom is like Enter, but only reports a cycle when the same schema is
+// already parsing the value, so that a lazy schema resolving to another lazy
+// schema does not mistake the value for one that contains itself.
+func (s *State) EnterFrom(schema interface{}, value interface{}) (*State, bool) {
+	entered := &State{parent: s, depth: s.Depth() + 1, options: s.Options()}
+	if s != nil {
+		entered.ctx = s.ctx
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Map, reflect.Ptr, reflect.Slice:
+		if v.IsNil() || v.Kind() == reflect.Slice && v.Len() == 0 {
+			return entered, true
+		}
+		entered.node = node{schema: schema, valueType: v.Type(), pointer: v.Pointer()}
+		if v.Kind() == reflect.Slice {
+			entered.node.length = v.Len()
+		}
+	default:
+		return entered, true
+	}
+
+	for ancestor := s; ancestor != nil; ancestor = ancestor.parent {
+		if ancestor.node == entered.node {
+			return entered, false
+		}
+	}
+	return entered, true
+}
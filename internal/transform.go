@@ -0,0 +1,69 @@
+package core
+
+import "context"
+
+// TransformSchema maps the output of its inner schema onto another type once
+// the inner schema has succeeded. An error returned by the transform function
+// fails the parse with a custom issue.
+type TransformSchema[T any, U any] struct {
+	Inner     Parser[T]
+	Transform func(T) (U, error)
+}
+
+func NewTransformSchema[T any, U any](inner Parser[T], transform func(T) (U, error)) *TransformSchema[T, U] {
+	return &TransformSchema[T, U]{Inner: inner, Transform: transform}
+}
+
+func (s *TransformSchema[T, U]) Parse(value interface{}) *Result[U] {
+	return s.ParseState(value, nil)
+}
+
+func (s *TransformSchema[T, U]) ParseContext(ctx context.Context, value interface{}) *Result[U] {
+	return ParseContext[U](ctx, s, value)
+}
+
+func (s *TransformSchema[T, U]) ParseState(value interface{}, state *State) *Result[U] {
+	return s.apply(ParseState(s.Inner, value, state))
+}
+
+// ParseTyped accepts an already transformed value as is, since the inner
+// schema only validates values before they are transformed.
+func (s *TransformSchema[T, U]) ParseTyped(value U) *Result[U] {
+	return &Result[U]{Ok: true, Value: value}
+}
+
+func (s *TransformSchema[T, U]) ParseAny(value interface{}) *Result[interface{}] {
+	return s.Parse(value).ToAny()
+}
+
+func (s *TransformSchema[T, U]) Describe() *Description {
+	return Describe(s.Inner)
+}
+
+func (s *TransformSchema[T, U]) ParseAnyState(value interface{}, state *State) *Result[interface{}] {
+	return s.ParseState(value, state).ToAny()
+}
+
+func (s *TransformSchema[T, U]) apply(result *Result[T]) *Result[U] {
+	if !result.Ok {
+		return failedAs[U](result)
+	}
+
+	transformed, err := s.Transform(result.Value)
+	if err != nil {
+		transformResult := &Result[U]{Path: result.Path}
+		transformResult.AddIssues(Issue{Code: Custom, Received: TypeName(result.Value), Message: err.Error()})
+		return transformResult
+	}
+
+	return &Result[U]{Ok: true, Path: result.Path, Value: transformed}
+}
+
+func failedAs[U any, T any](result *Result[T]) *Result[U] {
+	return &Result[U]{
+		Ok:     false,
+		Path:   result.Path,
+		Errors: result.Errors,
+		Issues: result.Issues,
+	}
+}
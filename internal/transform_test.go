@@ -0,0 +1,72 @@
+package core_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+type email struct {
+	Local  string
+	Domain string
+}
+
+func TestTransformSchema_Parse(t *testing.T) {
+	schema := core.NewTransformSchema(primitives.NewStringSchema("Email").Email(), func(value string) (email, error) {
+		local, domain, _ := strings.Cut(value, "@")
+		return email{Local: local, Domain: domain}, nil
+	})
+
+	result := schema.Parse("abyan@example.com")
+	assert.True(t, result.Ok)
+	assert.Equal(t, email{Local: "abyan", Domain: "example.com"}, result.Value)
+
+	result = schema.Parse("nope")
+	assert.False(t, result.Ok)
+	assert.Equal(t, email{}, result.Value)
+	assert.Equal(t, []string{"Must be a valid email address"}, result.Errors)
+}
+
+func TestTransformSchema_Error(t *testing.T) {
+	schema := core.NewTransformSchema(primitives.NewStringSchema("Age"), func(value string) (int, error) {
+		age, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, errors.New("Must be a whole number")
+		}
+		return age, nil
+	})
+
+	result := schema.Parse("42")
+	assert.True(t, result.Ok)
+	assert.Equal(t, 42, result.Value)
+
+	result = schema.Parse("forty-two")
+	assert.False(t, result.Ok)
+	assert.Equal(t, []core.Issue{{Code: core.Custom, Received: "string", Message: "Must be a whole number"}}, result.Issues)
+}
+
+func TestTransformSchema_ParseTyped(t *testing.T) {
+	upper := core.NewTransformSchema(primitives.NewStringSchema("Code").Length(3), func(value string) (string, error) {
+		return strings.ToUpper(value), nil
+	})
+	assert.Equal(t, "ABC", upper.ParseTyped("ABC").Value)
+
+	double := core.NewTransformSchema(primitives.NewNumberSchema[int]("Count"), func(value int) (int, error) {
+		return value * 2, nil
+	})
+	parsed := double.Parse(10)
+	assert.Equal(t, 20, parsed.Value)
+	assert.Equal(t, parsed.Value, double.ParseTyped(parsed.Value).Value)
+
+	length := core.NewTransformSchema(primitives.NewStringSchema("Name"), func(value string) (int, error) {
+		return len(value), nil
+	})
+	result := length.ParseTyped(5)
+	assert.True(t, result.Ok)
+	assert.Equal(t, 5, result.Value)
+}
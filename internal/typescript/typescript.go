@@ -0,0 +1,532 @@
+package typescript
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	core "github.com/abyanmajid/v/internal"
+)
+
+const indentUnit = "  "
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// stringMethods maps the string validations with a Zod counterpart to the
+// method that checks it.
+var stringMethods = map[string]string{
+	"email":  "email",
+	"url":    "url",
+	"uuid":   "uuid",
+	"date":   "date",
+	"time":   "time",
+	"ip":     "ip",
+	"cidr":   "cidr",
+	"nanoid": "nanoid",
+	"cuid":   "cuid",
+	"cuid2":  "cuid2",
+	"ulid":   "ulid",
+}
+
+const (
+	unvisited = iota
+	visiting
+	declared
+)
+
+// Generate returns the source of a TypeScript module that declares, for each
+// named schema, a type and a Zod (v3) schema named after it with a Schema
+// suffix, such as User and UserSchema. Like the JSON Schema export, checks
+// without a Zod counterpart, such as rules added through Refine, are left
+// out. Generate panics when a name is not a valid identifier.
+func Generate(schemas map[string]interface{}) string {
+	g := newGenerator()
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		if !identifierRegex.MatchString(name) {
+			panic(fmt.Sprintf("v: %q is not a valid TypeScript identifier", name))
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		g.define(name, core.Describe(schemas[name]))
+	}
+	for _, name := range names {
+		g.declare(name)
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by v. DO NOT EDIT.\n\nimport { z } from \"zod\";\n")
+	for _, declaration := range g.declarations {
+		b.WriteString("\n")
+		b.WriteString(declaration)
+	}
+	return b.String()
+}
+
+// generator converts descriptions into TypeScript types and Zod expressions.
+// Uses of named schemas, and of lazy schemas that refer back to themselves,
+// become references to a declaration of their own, which are emitted before
+// their first use so that Zod schemas are initialized in order.
+type generator struct {
+	defs         *core.Definitions
+	definitions  map[string]*core.Description
+	states       map[string]int
+	annotated    map[string]bool
+	declarations []string
+}
+
+func newGenerator() *generator {
+	return &generator{
+		defs:        core.NewDefinitions(),
+		definitions: map[string]*core.Description{},
+		states:      map[string]int{},
+		annotated:   map[string]bool{},
+	}
+}
+
+func (g *generator) define(name string, description *core.Description) {
+	g.defs.Define(name, description)
+	g.definitions[name] = description
+}
+
+// declare emits the declarations of a named schema once the ones it depends
+// on were emitted. A schema met again while it is being declared is part of
+// a cycle, so it is referenced through z.lazy and its Zod schema is
+// annotated with its type, which TypeScript cannot infer for cycles.
+func (g *generator) declare(name string) {
+	if g.states[name] != unvisited {
+		return
+	}
+	g.states[name] = visiting
+
+	description := g.definitions[name]
+	g.defs.FindRecursive(description)
+	resolved := description
+	if resolved.Kind == core.KindLazy {
+		resolved = g.defs.Resolve(resolved)
+	}
+	typ, zod := g.convertKind(resolved, "")
+	typ, zod = g.wrap(description, typ, zod, false)
+
+	g.states[name] = declared
+
+	var b strings.Builder
+	if description.Doc != "" {
+		fmt.Fprintf(&b, "/** %s */\n", docText(description.Doc))
+	}
+	fmt.Fprintf(&b, "export type %s = %s;\n\n", name, typ)
+	if g.annotated[name] {
+		fmt.Fprintf(&b, "export const %sSchema: z.ZodType<%s> = %s;\n", name, name, zod)
+	} else {
+		fmt.Fprintf(&b, "export const %sSchema = %s;\n", name, zod)
+	}
+	g.declarations = append(g.declarations, b.String())
+}
+
+func (g *generator) referTo(name string) (string, string) {
+	g.declare(name)
+	if g.states[name] == visiting {
+		g.annotated[name] = true
+		return name, fmt.Sprintf("z.lazy(() => %sSchema)", name)
+	}
+	return name, name + "Schema"
+}
+
+func (g *generator) convert(description *core.Description, indent string, isField bool) (string, string) {
+	if name, isNamed := g.defs.Reference(description); isNamed {
+		// References leave the description out, as the declaration they
+		// refer to already has it.
+		typ, zod := g.referTo(name)
+		undocumented := *description
+		undocumented.Doc = ""
+		return g.wrap(&undocumented, typ, zod, isField)
+	}
+	typ, zod := g.convertKind(description, indent)
+	return g.wrap(description, typ, zod, isField)
+}
+
+// wrap applies what optional, nullable and default wrappers add to a
+// description. Only fields can be left out, which objects mark with ? in
+// their type.
+func (g *generator) wrap(description *core.Description, typ string, zod string, isField bool) (string, string) {
+	if description.Nullable {
+		typ += " | null"
+		zod += ".nullable()"
+	}
+	if description.HasDefault {
+		if value, err := literal(description.Default); err == nil {
+			zod += fmt.Sprintf(".default(%s)", value)
+		}
+	} else if description.Optional && isField {
+		zod += ".optional()"
+	}
+	if description.Doc != "" {
+		zod += fmt.Sprintf(".describe(%s)", mustLiteral(description.Doc))
+	}
+	return typ, zod
+}
+
+func (g *generator) convertKind(description *core.Description, indent string) (string, string) {
+	switch description.Kind {
+	case core.KindLazy:
+		resolved := g.defs.Resolve(description)
+		if !g.defs.IsRecursive(description) {
+			return g.convert(resolved, indent, false)
+		}
+		name := g.defs.NameRecursive(description, identifierRegex.MatchString)
+		g.define(name, resolved)
+		return g.referTo(name)
+	case core.KindString:
+		return "string", g.convertString(description)
+	case core.KindNumber, core.KindInteger:
+		return "number", g.convertNumber(description)
+	case core.KindBoolean:
+		return "boolean", "z.boolean()"
+	case core.KindDate:
+		// Dates travel through JSON as RFC 3339 strings.
+		return "string", "z.string().datetime({ offset: true })"
+	case core.KindNil:
+		return "null", "z.null()"
+	case core.KindNever:
+		return "never", "z.never()"
+	case core.KindLiteral:
+		value := mustLiteral(description.Values[0])
+		return value, fmt.Sprintf("z.literal(%s)", value)
+	case core.KindEnum:
+		return g.convertEnum(description)
+	case core.KindArray, core.KindSet:
+		return g.convertArray(description, indent)
+	case core.KindTuple:
+		return g.convertTuple(description, indent)
+	case core.KindObject:
+		return g.convertObject(description, indent)
+	case core.KindRecord:
+		return g.convertRecord(description, indent)
+	case core.KindUnion:
+		return g.convertUnion(description, indent)
+	case core.KindIntersection:
+		return g.convertIntersection(description, indent)
+	}
+	return "unknown", "z.unknown()"
+}
+
+func (g *generator) convertString(description *core.Description) string {
+	zod := "z.string()"
+	for _, check := range description.Checks {
+		switch check.Code {
+		case core.TooSmall:
+			zod += fmt.Sprintf(".min(%v)", check.Params["min"])
+		case core.TooBig:
+			zod += fmt.Sprintf(".max(%v)", check.Params["max"])
+		case core.InvalidLength:
+			zod += fmt.Sprintf(".length(%v)", check.Params["length"])
+		case core.InvalidString:
+			validation, _ := check.Params["validation"].(string)
+			switch validation {
+			case "regex":
+				zod += fmt.Sprintf(".regex(%s)", regexLiteral(fmt.Sprint(check.Params["pattern"])))
+			case "includes", "starts_with", "ends_with":
+				method := map[string]string{"includes": "includes", "starts_with": "startsWith", "ends_with": "endsWith"}[validation]
+				zod += fmt.Sprintf(".%s(%s)", method, mustLiteral(check.Params[validation]))
+			default:
+				if method, hasMethod := stringMethods[validation]; hasMethod {
+					zod += fmt.Sprintf(".%s()", method)
+				}
+			}
+		}
+	}
+	return zod
+}
+
+func (g *generator) convertNumber(description *core.Description) string {
+	zod := "z.number()"
+	if description.Kind == core.KindInteger {
+		zod += ".int()"
+	}
+
+	for _, check := range description.Checks {
+		inclusive, _ := check.Params["inclusive"].(bool)
+		switch check.Code {
+		case core.TooSmall:
+			if inclusive {
+				zod += fmt.Sprintf(".gte(%v)", check.Params["min"])
+			} else {
+				zod += fmt.Sprintf(".gt(%v)", check.Params["min"])
+			}
+		case core.TooBig:
+			if inclusive {
+				zod += fmt.Sprintf(".lte(%v)", check.Params["max"])
+			} else {
+				zod += fmt.Sprintf(".lt(%v)", check.Params["max"])
+			}
+		case core.NotMultipleOf:
+			zod += fmt.Sprintf(".multipleOf(%v)", check.Params["multiple_of"])
+		case core.NotFinite:
+			zod += ".finite()"
+		}
+	}
+	return zod
+}
+
+// convertEnum uses z.enum for enums of strings, which only takes strings,
+// and a union of literals for any other enum. Enums built from struct tags
+// keep the checks of the field type.
+func (g *generator) convertEnum(description *core.Description) (string, string) {
+	values := make([]string, len(description.Values))
+	isStrings := true
+	for i, value := range description.Values {
+		values[i] = mustLiteral(value)
+		if value == nil || reflect.TypeOf(value).Kind() != reflect.String {
+			isStrings = false
+		}
+	}
+	typ := strings.Join(values, " | ")
+	if len(values) == 0 {
+		return "never", "z.never()"
+	}
+
+	if isStrings {
+		zod := fmt.Sprintf("z.enum([%s])", strings.Join(values, ", "))
+		if checks := strings.TrimPrefix(g.convertString(description), "z.string()"); checks != "" {
+			zod = fmt.Sprintf("%s.pipe(z.string()%s)", zod, checks)
+		}
+		return typ, zod
+	}
+
+	literals := make([]string, len(values))
+	for i, value := range values {
+		literals[i] = fmt.Sprintf("z.literal(%s)", value)
+	}
+	return typ, union(literals)
+}
+
+func (g *generator) convertArray(description *core.Description, indent string) (string, string) {
+	elementType, elementZod := "unknown", "z.unknown()"
+	if description.Element != nil {
+		elementType, elementZod = g.convert(description.Element, indent, false)
+	}
+	if isCompound(elementType) {
+		elementType = "(" + elementType + ")"
+	}
+
+	zod := fmt.Sprintf("z.array(%s)", elementZod)
+	for _, check := range description.Checks {
+		switch check.Code {
+		case core.TooSmall:
+			zod += fmt.Sprintf(".min(%v)", check.Params["min"])
+		case core.TooBig:
+			zod += fmt.Sprintf(".max(%v)", check.Params["max"])
+		case core.InvalidLength:
+			zod += fmt.Sprintf(".length(%v)", check.Params["length"])
+		}
+	}
+	return elementType + "[]", zod
+}
+
+func (g *generator) convertTuple(description *core.Description, indent string) (string, string) {
+	types := make([]string, len(description.Items))
+	zods := make([]string, len(description.Items))
+	for i, item := range description.Items {
+		types[i], zods[i] = g.convert(item, indent, false)
+	}
+
+	zod := fmt.Sprintf("z.tuple([%s])", strings.Join(zods, ", "))
+	if description.Rest != nil {
+		restType, restZod := g.convert(description.Rest, indent, false)
+		if isCompound(restType) {
+			restType = "(" + restType + ")"
+		}
+		types = append(types, "..."+restType+"[]")
+		zod += fmt.Sprintf(".rest(%s)", restZod)
+	}
+	return "[" + strings.Join(types, ", ") + "]", zod
+}
+
+func (g *generator) convertObject(description *core.Description, indent string) (string, string) {
+	if len(description.Fields) == 0 {
+		if description.UnknownKeys {
+			return "{}", "z.object({})"
+		}
+		return "{}", "z.object({}).strict()"
+	}
+
+	keys := make([]string, 0, len(description.Fields))
+	for key := range description.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	nested := indent + indentUnit
+	var typ, zod strings.Builder
+	typ.WriteString("{\n")
+	zod.WriteString("z.object({\n")
+	for _, key := range keys {
+		field := description.Fields[key]
+		fieldType, fieldZod := g.convert(field, nested, true)
+
+		property := key
+		if !identifierRegex.MatchString(key) {
+			property = mustLiteral(key)
+		}
+		marker := ""
+		if field.Optional && !field.HasDefault {
+			marker = "?"
+		}
+
+		if _, isNamed := g.defs.Reference(field); !isNamed && field.Doc != "" {
+			fmt.Fprintf(&typ, "%s/** %s */\n", nested, docText(field.Doc))
+		}
+		fmt.Fprintf(&typ, "%s%s%s: %s;\n", nested, property, marker, fieldType)
+		fmt.Fprintf(&zod, "%s%s: %s,\n", nested, property, fieldZod)
+	}
+	typ.WriteString(indent + "}")
+	zod.WriteString(indent + "})")
+
+	if !description.UnknownKeys {
+		zod.WriteString(".strict()")
+	}
+	return typ.String(), zod.String()
+}
+
+// convertRecord keeps the checks of string keys. Other keys become strings,
+// as they do once encoded as JSON.
+func (g *generator) convertRecord(description *core.Description, indent string) (string, string) {
+	keyType, keyZod := "string", "z.string()"
+	if description.Key != nil && isStringKind(description.Key.Kind) {
+		keyType, keyZod = g.convert(description.Key, indent, false)
+	}
+	valueType, valueZod := "unknown", "z.unknown()"
+	if description.Element != nil {
+		valueType, valueZod = g.convert(description.Element, indent, false)
+	}
+	return fmt.Sprintf("Record<%s, %s>", keyType, valueType), fmt.Sprintf("z.record(%s, %s)", keyZod, valueZod)
+}
+
+func (g *generator) convertUnion(description *core.Description, indent string) (string, string) {
+	types := make([]string, len(description.Options))
+	zods := make([]string, len(description.Options))
+	for i, option := range description.Options {
+		types[i], zods[i] = g.convert(option, indent, false)
+	}
+	if len(types) == 0 {
+		return "never", "z.never()"
+	}
+
+	typ := strings.Join(types, " | ")
+	if description.Discriminator != "" && len(zods) > 1 {
+		return typ, fmt.Sprintf("z.discriminatedUnion(%s, [%s])", mustLiteral(description.Discriminator), strings.Join(zods, ", "))
+	}
+	return typ, union(zods)
+}
+
+func (g *generator) convertIntersection(description *core.Description, indent string) (string, string) {
+	types := make([]string, len(description.Options))
+	zods := make([]string, len(description.Options))
+	for i, option := range description.Options {
+		types[i], zods[i] = g.convert(option, indent, false)
+		if isCompound(types[i]) {
+			types[i] = "(" + types[i] + ")"
+		}
+	}
+	if len(types) == 0 {
+		return "unknown", "z.unknown()"
+	}
+
+	zod := zods[0]
+	for _, next := range zods[1:] {
+		zod = fmt.Sprintf("z.intersection(%s, %s)", zod, next)
+	}
+	return strings.Join(types, " & "), zod
+}
+
+func union(zods []string) string {
+	if len(zods) == 1 {
+		return zods[0]
+	}
+	return fmt.Sprintf("z.union([%s])", strings.Join(zods, ", "))
+}
+
+// isCompound reports whether a type is a union or intersection, which needs
+// parentheses to be used as an array element or intersection member.
+func isCompound(typ string) bool {
+	depth := 0
+	for _, r := range typ {
+		switch r {
+		case '{', '[', '(', '<':
+			depth++
+		case '}', ']', ')', '>':
+			depth--
+		case '|', '&':
+			if depth == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isStringKind(kind string) bool {
+	switch kind {
+	case core.KindString, core.KindEnum, core.KindLiteral:
+		return true
+	}
+	return false
+}
+
+// docText escapes the end of a comment in a description, which would
+// otherwise close the doc comment it is written in.
+func docText(text string) string {
+	return strings.ReplaceAll(text, "*/", "*\\/")
+}
+
+// literal encodes a value as a JavaScript literal.
+func literal(value interface{}) (string, error) {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+func mustLiteral(value interface{}) string {
+	encoded, err := literal(value)
+	if err != nil {
+		return "undefined"
+	}
+	return encoded
+}
+
+// regexLiteral writes a pattern as a regular expression literal. Go and
+// JavaScript agree on the syntax of common patterns, but flags such as (?i)
+// have no JavaScript counterpart inside the pattern.
+func regexLiteral(pattern string) string {
+	var b strings.Builder
+	b.WriteByte('/')
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '/':
+			b.WriteByte('\\')
+		case r == '\n':
+			b.WriteString(`\n`)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('/')
+	return b.String()
+}
@@ -0,0 +1,143 @@
+package typescript_test
+
+import (
+	"regexp"
+	"testing"
+
+	core "github.com/abyanmajid/v/internal"
+	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/abyanmajid/v/internal/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	address := composites.NewObjectSchema("Address", composites.Shape{
+		"city": primitives.NewStringSchema("City").Min(1),
+		"zip":  primitives.NewStringSchema("Zip").Regex(regexp.MustCompile(`^\d{5}$`)),
+	}).Description("A postal address")
+
+	user := composites.NewObjectSchema("User", composites.Shape{
+		"email":    primitives.NewStringSchema("Email").Min(1).Email(),
+		"name":     primitives.NewStringSchema("Name").Max(50).Description("Full name"),
+		"nickname": core.NewOptionalSchema[string](primitives.NewStringSchema("Nickname")),
+		"age":      primitives.NewNumberSchema[int]("Age").Gte(18),
+		"role":     core.NewDefaultSchema[string](literals.NewEnumSchema("Role", []string{"admin", "member"}), func() string { return "member" }),
+		"address":  core.NewNullableSchema[map[string]interface{}](address),
+		"tags":     composites.NewArraySchema[string]("Tags", primitives.NewStringSchema("Tag")).Max(3),
+		"home-url": core.NewOptionalSchema[string](primitives.NewStringSchema("HomeURL").URL()),
+	})
+
+	assert.Equal(t, `// Code generated by v. DO NOT EDIT.
+
+import { z } from "zod";
+
+/** A postal address */
+export type Address = {
+  city: string;
+  zip: string;
+};
+
+export const AddressSchema = z.object({
+  city: z.string().min(1),
+  zip: z.string().regex(/^\d{5}$/),
+}).strict().describe("A postal address");
+
+export type User = {
+  address: Address | null;
+  age: number;
+  email: string;
+  "home-url"?: string;
+  /** Full name */
+  name: string;
+  nickname?: string;
+  role: "admin" | "member";
+  tags: string[];
+};
+
+export const UserSchema = z.object({
+  address: AddressSchema.nullable(),
+  age: z.number().int().gte(18),
+  email: z.string().min(1).email(),
+  "home-url": z.string().url().optional(),
+  name: z.string().max(50).describe("Full name"),
+  nickname: z.string().optional(),
+  role: z.enum(["admin", "member"]).default("member"),
+  tags: z.array(z.string()).max(3),
+}).strict();
+`, typescript.Generate(map[string]interface{}{"User": user, "Address": address}))
+}
+
+func TestGenerate_Composites(t *testing.T) {
+	point := composites.NewTupleSchema("Point", primitives.NewNumberSchema[float64]("X"), primitives.NewNumberSchema[float64]("Y"))
+	flags := composites.NewRecordSchema[string, bool]("Flags", primitives.NewStringSchema("Flag"), primitives.NewBooleanSchema("Enabled"))
+	event := composites.NewDiscriminatedUnionSchema("Event", "type", map[string]*composites.ObjectSchema{
+		"click": composites.NewObjectSchema("Click", composites.Shape{
+			"type": literals.NewLiteralSchema("Type", "click"),
+			"x":    primitives.NewNumberSchema[float64]("X"),
+		}),
+		"key": composites.NewObjectSchema("Key", composites.Shape{
+			"type": literals.NewLiteralSchema("Type", "key"),
+			"code": primitives.NewStringSchema("Code"),
+		}),
+	})
+	levels := composites.NewArraySchema[int]("Levels", literals.NewEnumSchema("Level", []int{1, 2}))
+	contacts := composites.NewArraySchema[string]("Contacts", composites.NewUnionSchema[string]("Contact",
+		literals.NewEnumSchema("Anonymous", []string{"none"}),
+		primitives.NewStringSchema("Email").Email(),
+	))
+
+	source := typescript.Generate(map[string]interface{}{"Point": point, "Flags": flags, "Event": event, "Levels": levels, "Contacts": contacts})
+	assert.Contains(t, source, "export type Point = [number, number];")
+	assert.Contains(t, source, "export const PointSchema = z.tuple([z.number(), z.number()]);")
+	assert.Contains(t, source, "export type Flags = Record<string, boolean>;")
+	assert.Contains(t, source, "export const FlagsSchema = z.record(z.string(), z.boolean());")
+	assert.Contains(t, source, `export const EventSchema = z.discriminatedUnion("type", [z.object({`)
+	assert.Contains(t, source, `  type: "click";`)
+	assert.Contains(t, source, `  type: z.literal("click"),`)
+	assert.Contains(t, source, "export type Levels = (1 | 2)[];")
+	assert.Contains(t, source, "export const LevelsSchema = z.array(z.union([z.literal(1), z.literal(2)]));")
+	assert.Contains(t, source, `export type Contacts = ("none" | string)[];`)
+	assert.Contains(t, source, `export const ContactsSchema = z.array(z.union([z.enum(["none"]), z.string().email()]));`)
+}
+
+type category struct {
+	Name     string     `json:"name" v:"required"`
+	Parent   *category  `json:"parent"`
+	Children []category `json:"children"`
+}
+
+func TestGenerate_Recursive(t *testing.T) {
+	source := typescript.Generate(map[string]interface{}{
+		"Category": composites.NewStructSchema[category]("Category"),
+	})
+
+	assert.Contains(t, source, `export type Category = {
+  children: Category[];
+  name: string;
+  parent?: Category | null;
+};`)
+	assert.Contains(t, source, `export const CategorySchema: z.ZodType<Category> = z.object({
+  children: z.array(z.lazy(() => CategorySchema)),
+  name: z.string(),
+  parent: z.lazy(() => CategorySchema).nullable().optional(),
+}).strict();`)
+}
+
+func TestGenerate_Names(t *testing.T) {
+	source := typescript.Generate(map[string]interface{}{
+		"Note": composites.NewObjectSchema("Note", composites.Shape{
+			"text": primitives.NewStringSchema("text").Description("Ends with */ alert(1) /*"),
+		}).Description("A note */"),
+	})
+	assert.Contains(t, source, `/** A note *\/ */`)
+	assert.Contains(t, source, `/** Ends with *\/ alert(1) /* */`)
+
+	assert.PanicsWithValue(t, `v: "User-Profile" is not a valid TypeScript identifier`, func() {
+		typescript.Generate(map[string]interface{}{"User-Profile": primitives.NewStringSchema("User")})
+	})
+	assert.Panics(t, func() {
+		typescript.Generate(map[string]interface{}{"A = string; alert(1); type B": primitives.NewStringSchema("A")})
+	})
+}
@@ -4,12 +4,114 @@ import (
 	core "github.com/abyanmajid/v/internal"
 	"github.com/abyanmajid/v/internal/coercion"
 	"github.com/abyanmajid/v/internal/composites"
+	"github.com/abyanmajid/v/internal/jsonschema"
 	"github.com/abyanmajid/v/internal/literals"
+	"github.com/abyanmajid/v/internal/openapi"
 	"github.com/abyanmajid/v/internal/primitives"
+	"github.com/abyanmajid/v/internal/typescript"
 )
 
 type Numeric primitives.Number
 
+type Parser[T any] interface {
+	core.Parser[T]
+}
+
+type Lookup[T any] interface {
+	core.Lookup[T]
+}
+
+type Issue = core.Issue
+
+type Path = core.Path
+
+type Shape = composites.Shape
+
+type ObjectSchema = composites.ObjectSchema
+
+type RefineOptions = core.RefineOptions
+
+type RefinementCtx = core.RefinementCtx
+
+type MessageFunc = core.MessageFunc
+
+type Catalog = core.Catalog
+
+type ParseOptions = core.ParseOptions
+
+// Fields is defined rather than aliased, as generic aliases need Go 1.24.
+type Fields[T any] composites.Fields[T]
+
+type Description = core.Description
+
+type JSONSchema = jsonschema.Schema
+
+type KeywordError = jsonschema.KeywordError
+
+type API = openapi.API
+
+type APIInfo = openapi.Info
+
+type Route = openapi.Route
+
+type Request = openapi.Request
+
+type ValidationError = openapi.ValidationError
+
+var English = core.English
+
+func RegisterCatalog(locale string, catalog *Catalog) {
+	core.RegisterCatalog(locale, catalog)
+}
+
+func NegotiateLocale(acceptLanguage string) string {
+	return core.NegotiateLocale(acceptLanguage)
+}
+
+func PluralOneOther(count float64) string {
+	return core.PluralOneOther(count)
+}
+
+func PluralOther(count float64) string {
+	return core.PluralOther(count)
+}
+
+func NewMemoryLookup[T comparable](values ...T) *core.MemoryLookup[T] {
+	return core.NewMemoryLookup(values...)
+}
+
+func ParseWith[T any](schema core.Parser[T], value interface{}, options ParseOptions) *core.Result[T] {
+	return core.ParseWith(schema, value, options)
+}
+
+func Describe(schema interface{}) *Description {
+	return core.Describe(schema)
+}
+
+func ToJSONSchema(schema interface{}) JSONSchema {
+	return jsonschema.From(schema)
+}
+
+func FromJSONSchema(document []byte) (core.Parser[interface{}], error) {
+	return jsonschema.Load(document)
+}
+
+func OpenAPIComponents(schemas map[string]interface{}) *openapi.Components {
+	return openapi.NewComponents(schemas)
+}
+
+func TypeScript(schemas map[string]interface{}) string {
+	return typescript.Generate(schemas)
+}
+
+func NewAPI(info APIInfo) *API {
+	return openapi.NewAPI(info)
+}
+
+func TypedBody[T any](schema core.Parser[T]) *openapi.TypedBody[T] {
+	return openapi.Typed(schema)
+}
+
 func String(path string) *primitives.StringSchema {
 	return primitives.NewStringSchema(path)
 }
@@ -50,10 +152,92 @@ func Enum[T comparable](path string, allowedValues []T) *literals.EnumSchema[T]
 	return literals.NewEnumSchema(path, allowedValues)
 }
 
-func Array[T any](path string, innerSchema *core.Schema[T]) *composites.ArraySchema[T] {
+func Array[T any](path string, innerSchema core.Parser[T]) *composites.ArraySchema[T] {
 	return composites.NewArraySchema[T](path, innerSchema)
 }
 
+func Object(path string, shape Shape) *composites.ObjectSchema {
+	return composites.NewObjectSchema(path, shape)
+}
+
+func Struct[T any](path string) *composites.StructSchema[T] {
+	return composites.NewStructSchema[T](path)
+}
+
+func StructOf[T any](path string, define func(b *Fields[T], x *T)) *composites.StructOfSchema[T] {
+	return composites.NewStructOfSchema(path, func(b *composites.Fields[T], x *T) {
+		define((*Fields[T])(b), x)
+	})
+}
+
+func Field[T any, F any](b *Fields[T], name string, field *F, schema core.Parser[F]) {
+	composites.Field((*composites.Fields[T])(b), name, field, schema)
+}
+
+func (b *Fields[T]) Names() []string {
+	return (*composites.Fields[T])(b).Names()
+}
+
+func Record[K comparable, V any](path string, keys core.Parser[K], values core.Parser[V]) *composites.RecordSchema[K, V] {
+	return composites.NewRecordSchema(path, keys, values)
+}
+
+func Set[T comparable](path string, innerSchema core.Parser[T]) *composites.SetSchema[T] {
+	return composites.NewSetSchema(path, innerSchema)
+}
+
+func Union[T any](path string, options ...core.Parser[T]) *composites.UnionSchema[T] {
+	return composites.NewUnionSchema(path, options...)
+}
+
+func DiscriminatedUnion[K comparable](path string, discriminator string, options map[K]*ObjectSchema) *composites.DiscriminatedUnionSchema[K] {
+	return composites.NewDiscriminatedUnionSchema(path, discriminator, options)
+}
+
+func Intersection[T any](path string, left core.Parser[T], right core.Parser[T]) *composites.IntersectionSchema[T] {
+	return composites.NewIntersectionSchema(path, left, right)
+}
+
+func Tuple(path string, items ...core.AnyParser) *composites.TupleSchema {
+	return composites.NewTupleSchema(path, items...)
+}
+
+func Tuple2[A any, B any](path string, item0 core.Parser[A], item1 core.Parser[B]) *composites.Tuple2Schema[A, B] {
+	return composites.NewTuple2Schema(path, item0, item1)
+}
+
+func Tuple3[A any, B any, C any](path string, item0 core.Parser[A], item1 core.Parser[B], item2 core.Parser[C]) *composites.Tuple3Schema[A, B, C] {
+	return composites.NewTuple3Schema(path, item0, item1, item2)
+}
+
+func Tuple4[A any, B any, C any, D any](path string, item0 core.Parser[A], item1 core.Parser[B], item2 core.Parser[C], item3 core.Parser[D]) *composites.Tuple4Schema[A, B, C, D] {
+	return composites.NewTuple4Schema(path, item0, item1, item2, item3)
+}
+
+func Lazy[T any](resolve func() Parser[T]) *core.LazySchema[T] {
+	return core.NewLazySchema(func() core.Parser[T] { return resolve() })
+}
+
+func Optional[T any](schema core.Parser[T]) *core.OptionalSchema[T] {
+	return core.NewOptionalSchema(schema)
+}
+
+func Nullable[T any](schema core.Parser[T]) *core.NullableSchema[T] {
+	return core.NewNullableSchema(schema)
+}
+
+func Transform[T any, U any](schema core.Parser[T], transform func(T) (U, error)) *core.TransformSchema[T, U] {
+	return core.NewTransformSchema(schema, transform)
+}
+
+func Preprocess[T any](preprocess func(interface{}) interface{}, schema core.Parser[T]) *core.PreprocessSchema[T] {
+	return core.NewPreprocessSchema(preprocess, schema)
+}
+
+func Pipe[A any, B any](first core.Parser[A], second core.Parser[B]) *core.PipeSchema[A, B] {
+	return core.NewPipeSchema(first, second)
+}
+
 type coercionExports struct {
 	String  func(path string) *coercion.CoerceStringSchema
 	Float   func(path string) *coercion.CoerceNumberSchema[float64]